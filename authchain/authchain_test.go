@@ -0,0 +1,146 @@
+package authchain
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	b64 "encoding/base64"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/device/neom9n"
+	"github.com/Hivemapper/gnss-controller/message"
+	"github.com/daedaleanai/ublox/ubx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var chainStart = time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+// signedData returns the SEC-ECSIGN of a NAV-PVT and a NAV-DOP, signed with
+// key, received at chainStart + i seconds
+func signedData(t *testing.T, key *ecdsa.PrivateKey, i int) *neom9n.Data {
+	navPvt, err := ubx.Encode(&ubx.NavPvt{
+		ITOW_ms:     uint32(1000 * i),
+		Year_y:      2024,
+		Month_month: 1,
+		Day_d:       1,
+		Hour_h:      10,
+		Sec_s:       byte(i),
+		FixType:     byte(ubx.NavPvtFix3D),
+		NumSV:       12,
+		Lat_dege7:   377749000 + int32(i),
+		Lon_dege7:   -1224194000,
+		HMSL_mm:     16000,
+		HAcc_mm:     1500,
+	})
+	require.NoError(t, err)
+	navDop, err := ubx.Encode(&ubx.NavDop{ITOW_ms: uint32(1000 * i)})
+	require.NoError(t, err)
+	frames := append(navPvt, navDop...)
+
+	sign := &ubx.SecEcsign{Version: 0x01, MsgNum: 2, FinalHash: sha256.Sum256(frames)}
+	r, s, err := ecdsa.Sign(rand.Reader, key, message.SignedDigest(sign.FinalHash, sign.SessionId))
+	require.NoError(t, err)
+	r.FillBytes(sign.EcdsaSignature[:24])
+	s.FillBytes(sign.EcdsaSignature[24:])
+
+	return &neom9n.Data{
+		SystemTime:      chainStart.Add(time.Duration(i) * time.Second),
+		SecEcsign:       sign,
+		SecEcsignBuffer: b64.StdEncoding.EncodeToString(frames),
+		AuthStatus:      message.AuthVerified,
+	}
+}
+
+func Test_ExportAndVerify(t *testing.T) {
+	key, err := ecdsa.GenerateKey(message.P192(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(message.P192(), rand.Reader)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	recorder := NewRecorder(dir, 1024, 3)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, recorder.Record(signedData(t, key, i)))
+	}
+	require.NoError(t, recorder.Close())
+
+	files, err := chainFiles(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 3, "the oldest files are removed")
+
+	bundle, err := Export(dir, chainStart.Add(7*time.Second), chainStart.Add(9*time.Second))
+	require.NoError(t, err)
+	require.Len(t, bundle.Links, 2, "the end of the range is excluded")
+	require.Len(t, bundle.Links[0].Fixes, 1)
+	assert.Equal(t, Fix{
+		Time:      chainStart.Add(7 * time.Second),
+		ITOW:      7000,
+		FixType:   byte(ubx.NavPvtFix3D),
+		NumSV:     12,
+		Latitude:  float64(377749007) * 1e-7,
+		Longitude: float64(-1224194000) * 1e-7,
+		Height:    16,
+		HAcc:      1.5,
+	}, bundle.Links[0].Fixes[0])
+
+	path := filepath.Join(t.TempDir(), "bundle.json")
+	require.NoError(t, bundle.Write(path))
+	bundle, err = ReadBundle(path)
+	require.NoError(t, err)
+
+	report := bundle.Verify(&key.PublicKey)
+	assert.Equal(t, 2, report.Count(message.AuthVerified), report.String())
+
+	report = bundle.Verify(nil)
+	assert.Equal(t, 2, report.Count(message.AuthUnknown), "without a public key only the hashes are verified")
+
+	report = bundle.Verify(&otherKey.PublicKey)
+	assert.Equal(t, 2, report.Count(message.AuthFailed))
+
+	bundle.Links[0].Fixes[0].Latitude = 48.85
+	bundle.Links[1].Frames[10] ^= 0xff
+	report = bundle.Verify(&key.PublicKey)
+	require.Len(t, report.Results, 2)
+	assert.Equal(t, message.AuthFailed, report.Results[0].Status)
+	assert.EqualError(t, report.Results[0].Err, "the fixes aren't the ones of the signed frames")
+	assert.Equal(t, message.AuthFailed, report.Results[1].Status)
+	assert.Contains(t, report.Results[1].Err.Error(), "isn't the final hash")
+}
+
+func Test_VerifyPartialLink(t *testing.T) {
+	key, err := ecdsa.GenerateKey(message.P192(), rand.Reader)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	recorder := NewRecorder(dir, 1<<20, 3)
+	for i := 0; i < 3; i++ {
+		data := signedData(t, key, i)
+		if i == 0 {
+			// the logger started after the NAV-PVT signed
+			frames, err := b64.StdEncoding.DecodeString(data.SecEcsignBuffer)
+			require.NoError(t, err)
+			data.SecEcsignBuffer = b64.StdEncoding.EncodeToString(frames[8+92:])
+			data.AuthStatus = message.AuthUnknown
+			data.SecEcsignPartial = true
+		}
+		require.NoError(t, recorder.Record(data))
+	}
+	require.NoError(t, recorder.Close())
+
+	bundle, err := Export(dir, chainStart, chainStart.Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, bundle.Links, 3)
+	assert.True(t, bundle.Links[0].Partial)
+
+	report := bundle.Verify(&key.PublicKey)
+	require.Len(t, report.Results, 3)
+	assert.Equal(t, message.AuthUnknown, report.Results[0].Status, "the hash of a partial link doesn't match")
+	assert.Equal(t, 2, report.Count(message.AuthVerified), report.String())
+
+	bundle.Links[0].Partial = false
+	report = bundle.Verify(&key.PublicKey)
+	assert.Equal(t, message.AuthFailed, report.Results[0].Status)
+}
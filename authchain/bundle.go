@@ -0,0 +1,187 @@
+package authchain
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/message"
+)
+
+const BundleVersion = 1
+
+// Bundle is the chain over a time range, self-contained: it holds the frames
+// of every link so it can be verified without the logger or the receiver.
+// PublicKey is the key the bundle was exported with, a verifier should
+// rather use a key it got from a trusted source.
+type Bundle struct {
+	Version   int       `json:"version"`
+	Start     time.Time `json:"start"`
+	End       time.Time `json:"end"`
+	PublicKey string    `json:"public_key,omitempty"`
+	Links     []*Link   `json:"links"`
+}
+
+// Export reads the links recorded in dir between start, included, and end,
+// excluded.
+func Export(dir string, start time.Time, end time.Time) (*Bundle, error) {
+	names, err := chainFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &Bundle{Version: BundleVersion, Start: start.UTC(), End: end.UTC()}
+	for _, name := range names {
+		links, err := readLinks(name)
+		if err != nil {
+			return nil, err
+		}
+		for _, link := range links {
+			if !link.SystemTime.Before(start) && link.SystemTime.Before(end) {
+				bundle.Links = append(bundle.Links, link)
+			}
+		}
+	}
+	return bundle, nil
+}
+
+func readLinks(path string) ([]*Link, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening gnss auth chain file: %w", err)
+	}
+	defer file.Close()
+
+	var links []*Link
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// a last line without its end was cut by a crash
+			return links, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading gnss auth chain file %s: %w", path, err)
+		}
+		link := &Link{}
+		if err := json.Unmarshal(line, link); err != nil {
+			return nil, fmt.Errorf("decoding gnss auth chain file %s: %w", path, err)
+		}
+		links = append(links, link)
+	}
+}
+
+func (b *Bundle) Write(path string) error {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding gnss auth bundle: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing gnss auth bundle: %w", err)
+	}
+	return nil
+}
+
+func ReadBundle(path string) (*Bundle, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading gnss auth bundle: %w", err)
+	}
+	bundle := &Bundle{}
+	if err := json.Unmarshal(data, bundle); err != nil {
+		return nil, fmt.Errorf("decoding gnss auth bundle %s: %w", path, err)
+	}
+	if bundle.Version != BundleVersion {
+		return nil, fmt.Errorf("gnss auth bundle %s: unsupported version %d", path, bundle.Version)
+	}
+	return bundle, nil
+}
+
+// LinkResult is the verification of one link, Err tells why it failed
+type LinkResult struct {
+	SystemTime time.Time
+	Fixes      int
+	Status     message.AuthStatus
+	Err        error
+}
+
+func (r LinkResult) String() string {
+	s := fmt.Sprintf("%s: %s, %d fixes", r.SystemTime.Format(time.RFC3339Nano), r.Status, r.Fixes)
+	if r.Err != nil {
+		s += ": " + r.Err.Error()
+	}
+	return s
+}
+
+type Report struct {
+	Results []LinkResult
+}
+
+// Count returns the number of links with status
+func (r *Report) Count(status message.AuthStatus) int {
+	count := 0
+	for _, result := range r.Results {
+		if result.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+func (r *Report) String() string {
+	lines := make([]string, 0, len(r.Results)+1)
+	for _, result := range r.Results {
+		lines = append(lines, result.String())
+	}
+	lines = append(lines, fmt.Sprintf("%d links: %d verified, %d failed, %d unknown", len(r.Results),
+		r.Count(message.AuthVerified), r.Count(message.AuthFailed), r.Count(message.AuthUnknown)))
+	return strings.Join(lines, "\n")
+}
+
+// Verify checks every link of the bundle: the hash of its frames, its
+// signature with publicKey, and that its fixes are the ones of its frames.
+// Without a public key the links are unknown at best, so are the partial
+// links whose hash doesn't match.
+func (b *Bundle) Verify(publicKey *ecdsa.PublicKey) *Report {
+	report := &Report{}
+	for _, link := range b.Links {
+		verify := message.VerifySecEcsign
+		if link.Partial {
+			verify = message.VerifyPartialSecEcsign
+		}
+		status, err := verify(link.SecEcsign(), [][]byte{link.Frames}, publicKey)
+		if err == nil && !sameFixes(link.Fixes, decodeFixes(link.Frames)) {
+			status, err = message.AuthFailed, errors.New("the fixes aren't the ones of the signed frames")
+		}
+		report.Results = append(report.Results, LinkResult{
+			SystemTime: link.SystemTime,
+			Fixes:      len(link.Fixes),
+			Status:     status,
+			Err:        err,
+		})
+	}
+	return report
+}
+
+func sameFixes(a []Fix, b []Fix) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		x, y := a[i], b[i]
+		if !x.Time.Equal(y.Time) {
+			return false
+		}
+		x.Time, y.Time = time.Time{}, time.Time{}
+		if x != y {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,112 @@
+// Package authchain records the authentication chain of the gnss receiver:
+// every UBX-SEC-ECSIGN with the exact frames it signs. A bundle of the chain
+// over a time range can be verified offline, without the logger.
+package authchain
+
+import (
+	"bytes"
+	b64 "encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/device/neom9n"
+	"github.com/Hivemapper/gnss-controller/message"
+	"github.com/daedaleanai/ublox"
+	"github.com/daedaleanai/ublox/ubx"
+)
+
+// Link is one SEC-ECSIGN of the chain. Frames are the bytes it signs, as
+// received, and Fixes the NAV-PVT solutions decoded from them. Frames may
+// miss some of the signed bytes when Partial: the logger started after them,
+// or dropped a corrupted frame.
+type Link struct {
+	SystemTime     time.Time          `json:"system_time"`
+	Version        uint8              `json:"version"`
+	MsgNum         uint16             `json:"msg_num"`
+	FinalHash      []byte             `json:"final_hash"`
+	SessionId      []byte             `json:"session_id"`
+	EcdsaSignature []byte             `json:"ecdsa_signature"`
+	AuthStatus     message.AuthStatus `json:"auth_status"`
+	Partial        bool               `json:"partial"`
+	Frames         []byte             `json:"frames"`
+	Fixes          []Fix              `json:"fixes"`
+}
+
+// Fix is a NAV-PVT solution found in the frames of a link
+type Fix struct {
+	Time      time.Time `json:"time"`
+	ITOW      uint32    `json:"itow_ms"`
+	FixType   byte      `json:"fix_type"`
+	NumSV     byte      `json:"num_sv"`
+	Latitude  float64   `json:"latitude"`
+	Longitude float64   `json:"longitude"`
+	Height    float64   `json:"height"`
+	HAcc      float64   `json:"h_acc"`
+}
+
+func NewLink(data *neom9n.Data) (*Link, error) {
+	if data.SecEcsign == nil {
+		return nil, fmt.Errorf("gnss data without SEC-ECSIGN")
+	}
+	frames, err := b64.StdEncoding.DecodeString(data.SecEcsignBuffer)
+	if err != nil {
+		return nil, fmt.Errorf("decoding SEC-ECSIGN buffer: %w", err)
+	}
+	sign := data.SecEcsign
+	return &Link{
+		SystemTime:     data.SystemTime,
+		Version:        sign.Version,
+		MsgNum:         sign.MsgNum,
+		FinalHash:      append([]byte(nil), sign.FinalHash[:]...),
+		SessionId:      append([]byte(nil), sign.SessionId[:]...),
+		EcdsaSignature: append([]byte(nil), sign.EcdsaSignature[:]...),
+		AuthStatus:     data.AuthStatus,
+		Partial:        data.SecEcsignPartial,
+		Frames:         frames,
+		Fixes:          decodeFixes(frames),
+	}, nil
+}
+
+// SecEcsign returns the SEC-ECSIGN message of the link
+func (l *Link) SecEcsign() *ubx.SecEcsign {
+	sign := &ubx.SecEcsign{
+		Version: l.Version,
+		MsgNum:  l.MsgNum,
+	}
+	copy(sign.FinalHash[:], l.FinalHash)
+	copy(sign.SessionId[:], l.SessionId)
+	copy(sign.EcdsaSignature[:], l.EcdsaSignature)
+	return sign
+}
+
+// decodeFixes returns the NAV-PVT solutions found in frames
+func decodeFixes(frames []byte) []Fix {
+	var fixes []Fix
+	decoder := ublox.NewDecoder(bytes.NewReader(frames))
+	for {
+		msg, frame, err := decoder.Decode()
+		if frame == nil {
+			return fixes
+		}
+		if err != nil {
+			continue
+		}
+		if navPvt, ok := msg.(*ubx.NavPvt); ok {
+			fixes = append(fixes, newFix(navPvt))
+		}
+	}
+}
+
+func newFix(navPvt *ubx.NavPvt) Fix {
+	return Fix{
+		Time: time.Date(int(navPvt.Year_y), time.Month(navPvt.Month_month), int(navPvt.Day_d),
+			int(navPvt.Hour_h), int(navPvt.Min_min), int(navPvt.Sec_s), int(navPvt.Nano_ns), time.UTC),
+		ITOW:      navPvt.ITOW_ms,
+		FixType:   navPvt.FixType,
+		NumSV:     navPvt.NumSV,
+		Latitude:  float64(navPvt.Lat_dege7) * 1e-7,
+		Longitude: float64(navPvt.Lon_dege7) * 1e-7,
+		Height:    float64(navPvt.HMSL_mm) / 1000,
+		HAcc:      float64(navPvt.HAcc_mm) / 1000,
+	}
+}
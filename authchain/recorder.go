@@ -0,0 +1,138 @@
+package authchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/device/neom9n"
+)
+
+const (
+	filePrefix    = "gnss-auth-"
+	fileExtension = ".jsonl"
+)
+
+// Recorder appends every link of the chain as a JSON line to rotating files
+// in dir. A new file is started when the current one reaches maxFileSize, the
+// oldest files are removed to keep at most maxFiles.
+type Recorder struct {
+	lock sync.Mutex
+
+	dir         string
+	maxFileSize int64
+	maxFiles    int
+
+	file *os.File
+	size int64
+}
+
+func NewRecorder(dir string, maxFileSize int64, maxFiles int) *Recorder {
+	return &Recorder{
+		dir:         dir,
+		maxFileSize: maxFileSize,
+		maxFiles:    maxFiles,
+	}
+}
+
+// Record appends the SEC-ECSIGN of data and the frames it signs to the chain
+func (r *Recorder) Record(data *neom9n.Data) error {
+	link, err := NewLink(data)
+	if err != nil {
+		return err
+	}
+	line, err := json.Marshal(link)
+	if err != nil {
+		return fmt.Errorf("encoding gnss auth link: %w", err)
+	}
+	line = append(line, '\n')
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.file == nil || r.size >= r.maxFileSize {
+		if err := r.rotate(link.SystemTime); err != nil {
+			return err
+		}
+	}
+	if _, err := r.file.Write(line); err != nil {
+		return fmt.Errorf("writing gnss auth chain: %w", err)
+	}
+	r.size += int64(len(line))
+	return nil
+}
+
+func (r *Recorder) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.closeFile()
+}
+
+func (r *Recorder) rotate(now time.Time) error {
+	if err := r.closeFile(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(r.dir, 0755); err != nil {
+		return fmt.Errorf("creating gnss auth chain directory: %w", err)
+	}
+
+	name := filepath.Join(r.dir, filePrefix+now.UTC().Format("20060102T150405.000Z")+fileExtension)
+	file, err := os.Create(name)
+	if err != nil {
+		return fmt.Errorf("creating gnss auth chain file: %w", err)
+	}
+	r.file = file
+	r.size = 0
+
+	return r.removeOldFiles()
+}
+
+func (r *Recorder) removeOldFiles() error {
+	names, err := chainFiles(r.dir)
+	if err != nil {
+		return err
+	}
+	for len(names) > r.maxFiles {
+		fmt.Println("removing gnss auth chain file", names[0])
+		if err := os.Remove(names[0]); err != nil {
+			return fmt.Errorf("removing gnss auth chain file: %w", err)
+		}
+		names = names[1:]
+	}
+	return nil
+}
+
+func (r *Recorder) closeFile() error {
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	if err != nil {
+		return fmt.Errorf("closing gnss auth chain file: %w", err)
+	}
+	return nil
+}
+
+// chainFiles returns the paths of the chain files in dir, oldest first
+func chainFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("listing gnss auth chain directory: %w", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), filePrefix) && strings.HasSuffix(entry.Name(), fileExtension) {
+			names = append(names, filepath.Join(dir, entry.Name()))
+		}
+	}
+	// names hold the creation time, sorting them sorts the files by age
+	sort.Strings(names)
+	return names, nil
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/message"
+	"github.com/Hivemapper/hivemapper-data-logger/authchain"
+	"github.com/spf13/cobra"
+)
+
+var ExportAuthCmd = &cobra.Command{
+	Use:   "export-auth <bundle.json>",
+	Short: "Export the gnss authentication chain over a time range into a bundle",
+	Long: `Writes the SEC-ECSIGN signatures recorded with --gnss-auth-chain-dir
+between --start and --end, with the frames they sign and the NAV-PVT fixes of
+these frames, into a self-contained bundle for verify-auth.`,
+	Args: cobra.ExactArgs(1),
+	RunE: exportAuthRun,
+}
+
+var VerifyAuthCmd = &cobra.Command{
+	Use:   "verify-auth <bundle.json>",
+	Short: "Verify a gnss authentication bundle offline",
+	Long: `Checks the hash of the frames of every signature of the bundle, the
+signature itself with --gnss-public-key, and that the fixes of the bundle are
+the ones of the signed frames. The public key of the bundle is only used when
+--gnss-public-key isn't set. Fails if any signature fails.`,
+	Args: cobra.ExactArgs(1),
+	RunE: verifyAuthRun,
+}
+
+func init() {
+	ExportAuthCmd.Flags().String("gnss-auth-chain-dir", "", "directory of the recorded gnss auth chain")
+	ExportAuthCmd.Flags().String("start", "", "start of the exported range, RFC 3339")
+	ExportAuthCmd.Flags().String("end", "", "end of the exported range, RFC 3339, now when empty")
+	ExportAuthCmd.Flags().String("gnss-public-key", "", "hex P-192 public key of the gnss receiver, written to the bundle")
+	RootCmd.AddCommand(ExportAuthCmd)
+
+	VerifyAuthCmd.Flags().String("gnss-public-key", "", "hex P-192 public key of the gnss receiver, the key of the bundle when empty")
+	RootCmd.AddCommand(VerifyAuthCmd)
+}
+
+func exportAuthRun(cmd *cobra.Command, args []string) error {
+	dir := mustGetString(cmd, "gnss-auth-chain-dir")
+	if dir == "" {
+		return fmt.Errorf("gnss-auth-chain-dir must be set")
+	}
+	start, err := time.Parse(time.RFC3339, mustGetString(cmd, "start"))
+	if err != nil {
+		return fmt.Errorf("parsing start: %w", err)
+	}
+	end := time.Now().UTC()
+	if value := mustGetString(cmd, "end"); value != "" {
+		end, err = time.Parse(time.RFC3339, value)
+		if err != nil {
+			return fmt.Errorf("parsing end: %w", err)
+		}
+	}
+	publicKey := mustGetString(cmd, "gnss-public-key")
+	if _, err := loadGnssPublicKey(publicKey); err != nil {
+		return err
+	}
+
+	bundle, err := authchain.Export(dir, start, end)
+	if err != nil {
+		return fmt.Errorf("exporting gnss auth chain: %w", err)
+	}
+	bundle.PublicKey = publicKey
+	if err := bundle.Write(args[0]); err != nil {
+		return err
+	}
+	fmt.Printf("Exported %d signatures into %s\n", len(bundle.Links), args[0])
+	return nil
+}
+
+func verifyAuthRun(cmd *cobra.Command, args []string) error {
+	bundle, err := authchain.ReadBundle(args[0])
+	if err != nil {
+		return err
+	}
+
+	publicKey := mustGetString(cmd, "gnss-public-key")
+	if publicKey == "" && bundle.PublicKey != "" {
+		fmt.Println("WARNING: verifying with the public key of the bundle itself")
+		publicKey = bundle.PublicKey
+	}
+	key, err := loadGnssPublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		fmt.Println("WARNING: no public key, only the hashes are verified")
+	}
+
+	report := bundle.Verify(key)
+	fmt.Println(report)
+	if failed := report.Count(message.AuthFailed); failed > 0 {
+		return fmt.Errorf("%d of %d signatures failed", failed, len(report.Results))
+	}
+	return nil
+}
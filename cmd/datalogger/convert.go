@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/Hivemapper/hivemapper-data-logger/data/gnss"
+	"github.com/spf13/cobra"
+)
+
+var ConvertGnssReplayCmd = &cobra.Command{
+	Use:   "convert-gnss-replay <input.jsonl> <output>",
+	Short: "Convert a json lines gnss replay file into the binary replay format",
+	Args:  cobra.ExactArgs(2),
+	RunE:  convertGnssReplayRun,
+}
+
+func init() {
+	RootCmd.AddCommand(ConvertGnssReplayCmd)
+}
+
+func convertGnssReplayRun(_ *cobra.Command, args []string) error {
+	count, err := gnss.ConvertJsonReplayFile(args[0], args[1])
+	if err != nil {
+		return fmt.Errorf("converting %s: %w", args[0], err)
+	}
+	fmt.Printf("Converted %d records into %s\n", count, args[1])
+	return nil
+}
@@ -5,43 +5,34 @@ import (
 	"time"
 
 	"github.com/Hivemapper/gnss-controller/device/neom9n"
+	"github.com/Hivemapper/hivemapper-data-logger/authchain"
+	"github.com/Hivemapper/hivemapper-data-logger/data/gnss"
 	"github.com/Hivemapper/hivemapper-data-logger/data/imu"
 	"github.com/Hivemapper/hivemapper-data-logger/logger"
+	"github.com/Hivemapper/hivemapper-data-logger/recording"
+	"github.com/Hivemapper/hivemapper-data-logger/webconnect"
 	"github.com/streamingfast/imu-controller/device/iim42652"
 )
 
 type DataHandler struct {
+	sinks             logger.Sinks
 	redisLogger       *logger.Redis
+	eventServer       *webconnect.EventServer
+	recorder          *recording.Writer
+	authChain         *authchain.Recorder
 	gnssData          *neom9n.Data
 	lastImageFileName string
-	redisLogsEnabled  bool
-	gnssAuthCount     int
 }
 
-func NewDataHandler(
-	redisLogsEnabled bool,
-	maxRedisImuEntries int,
-	maxRedisMagEntries int,
-	maxRedisGnssEntries int,
-	maxRedisGnssAuthEntries int,
-	redisLogProtoText bool,
-	redisWriteGnssToFile string,
-) (*DataHandler, error) {
-
-	var redisLogger *logger.Redis = nil
-	var err error
-	if redisLogsEnabled {
-		redisLogger = logger.NewRedis(maxRedisImuEntries, maxRedisMagEntries, maxRedisGnssEntries, maxRedisGnssAuthEntries, redisLogProtoText, redisWriteGnssToFile)
-		err := redisLogger.Init()
-		if err != nil {
-			return nil, fmt.Errorf("initializing redis logger database: %w", err)
-		}
-	}
-
+// NewDataHandler fans out every sensor record to the sinks. The redis logger
+// is only needed to push gnss replay data and the event server for derived
+// events, both can be nil.
+func NewDataHandler(sinks logger.Sinks, redisLogger *logger.Redis, eventServer *webconnect.EventServer) *DataHandler {
 	return &DataHandler{
-		redisLogger:      redisLogger,
-		redisLogsEnabled: redisLogsEnabled,
-	}, err
+		sinks:       sinks,
+		redisLogger: redisLogger,
+		eventServer: eventServer,
+	}
 }
 
 func (h *DataHandler) HandleImage(imageFileName string) error {
@@ -55,21 +46,29 @@ func (h *DataHandler) HandleOrientedAcceleration(
 	temperature iim42652.Temperature,
 	orientation imu.Orientation,
 ) error {
+	if h.eventServer != nil {
+		h.eventServer.HandleEvent(imu.NewOrientedAccelerationEvent(acceleration, tiltAngles, temperature, orientation))
+	}
 	return nil
 }
 
 func (h *DataHandler) HandlerGnssData(data *neom9n.Data) error {
+	if h.recorder != nil {
+		h.recorder.RecordGnssData(data)
+	}
+	if h.eventServer != nil {
+		h.eventServer.HandleEvent(gnss.NewGnssEvent(data))
+	}
+	if h.authChain != nil && data.SecEcsign != nil {
+		if err := h.authChain.Record(data); err != nil {
+			fmt.Println("WARNING: recording gnss auth chain:", err)
+		}
+	}
 	if data.SecEcsign != nil {
-		if h.gnssAuthCount%60 == 0 {
-			if h.redisLogsEnabled {
-				err := h.redisLogger.LogGnssAuthData(*data)
-				if err != nil {
-					return fmt.Errorf("logging gnss data to redis: %w", err)
-				}
-			}
+		err := h.sinks.LogGnssAuth(data)
+		if err != nil {
+			return fmt.Errorf("logging gnss auth data: %w", err)
 		}
-		h.gnssAuthCount += 1
-		return nil
 	}
 
 	return nil
@@ -91,6 +90,11 @@ func calibrate(mag_x float64, mag_y float64, mag_z float64, transform [3][3]floa
 }
 
 func (h *DataHandler) HandlerMagnetometerData(system_time time.Time, mag_x float64, mag_y float64, mag_z float64) error {
+	if h.recorder != nil {
+		// the raw values are recorded so a replay goes through the calibration again
+		h.recorder.RecordMagnetometer(system_time, mag_x, mag_y, mag_z)
+	}
+
 	var center [3]float64
 	var transform [3][3]float64
 	center = [3]float64{0, 0, 0}
@@ -101,24 +105,34 @@ func (h *DataHandler) HandlerMagnetometerData(system_time time.Time, mag_x float
 	}
 
 	calibrated_mag := calibrate(mag_x, mag_y, mag_z, transform, center)
-	magDataWrapper := logger.NewMagnetometerRedisWrapper(system_time, calibrated_mag[0], calibrated_mag[1], calibrated_mag[2])
-	if h.redisLogsEnabled {
-		err := h.redisLogger.LogMagnetometerData(*magDataWrapper)
-		if err != nil {
-			return fmt.Errorf("logging magnetometer data to redis: %w", err)
-		}
+	err := h.sinks.LogMagnetometer(logger.NewMagnetometerRecord(system_time, calibrated_mag[0], calibrated_mag[1], calibrated_mag[2]))
+	if err != nil {
+		return fmt.Errorf("logging magnetometer data: %w", err)
 	}
 
 	return nil
 }
 
 func (h *DataHandler) HandleRawImuFeed(acceleration *imu.Acceleration, angularRate *iim42652.AngularRate, temperature iim42652.Temperature, fsync *iim42652.Fsync) error {
-	imuDataWrapper := logger.NewImuRedisWrapper(time.Now().UTC(), temperature, acceleration, angularRate, fsync)
-	if h.redisLogsEnabled {
-		err := h.redisLogger.LogImuData(*imuDataWrapper)
-		if err != nil {
-			return fmt.Errorf("logging raw imu data to redis: %w", err)
-		}
+	if h.recorder != nil {
+		h.recorder.RecordImu(acceleration, angularRate, temperature, fsync)
+	}
+	err := h.sinks.LogImu(logger.NewImuRecord(time.Now().UTC(), acceleration, angularRate, temperature, fsync))
+	if err != nil {
+		return fmt.Errorf("logging raw imu data: %w", err)
+	}
+	return nil
+}
+
+// HandleUbxMessage never fails: an error returned to the gnss decoder would
+// stop the whole gnss feed because a single sink had a hiccup.
+func (h *DataHandler) HandleUbxMessage(msg interface{}) error {
+	if h.recorder != nil {
+		h.recorder.RecordUbxMessage(msg)
+	}
+	err := h.sinks.HandleUbxMessage(msg)
+	if err != nil {
+		fmt.Printf("logging ubx message %s: %s\n", logger.UbxMessageName(msg), err)
 	}
 	return nil
 }
@@ -126,3 +140,12 @@ func (h *DataHandler) HandleRawImuFeed(acceleration *imu.Acceleration, angularRa
 func (h *DataHandler) HandleGnssReplayData(redisKey string, data []byte) error {
 	return h.redisLogger.LogGnssReplayData(redisKey, data)
 }
+
+func (h *DataHandler) Close() error {
+	if h.recorder != nil {
+		if err := h.recorder.Close(); err != nil {
+			fmt.Println("closing session recording:", err)
+		}
+	}
+	return h.sinks.Close()
+}
@@ -1,19 +1,42 @@
 package main
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
 	"fmt"
+	"io/fs"
+	"os"
+	"os/signal"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
+	"github.com/Hivemapper/gnss-controller/config"
 	"github.com/Hivemapper/gnss-controller/device/neom9n"
+	"github.com/Hivemapper/gnss-controller/message"
+	"github.com/Hivemapper/gnss-controller/transport"
+	"github.com/Hivemapper/hivemapper-data-logger/authchain"
 	"github.com/Hivemapper/hivemapper-data-logger/data/gnss"
 	"github.com/Hivemapper/hivemapper-data-logger/data/imu"
 	"github.com/Hivemapper/hivemapper-data-logger/data/magnetometer"
+	"github.com/Hivemapper/hivemapper-data-logger/data/session"
+	"github.com/Hivemapper/hivemapper-data-logger/httpapi"
+	"github.com/Hivemapper/hivemapper-data-logger/logger"
+	"github.com/Hivemapper/hivemapper-data-logger/recording"
+	"github.com/Hivemapper/hivemapper-data-logger/supervisor"
+	"github.com/Hivemapper/hivemapper-data-logger/webconnect"
 	"github.com/spf13/cobra"
 	"github.com/streamingfast/imu-controller/device/iim42652"
 )
 
+const (
+	feedRestartInitialBackoff = time.Second
+	feedRestartMaxBackoff     = 30 * time.Second
+	shutdownTimeout           = 10 * time.Second
+)
+
 var LogCmd = &cobra.Command{
 	Use:   "log",
 	Short: "Start the data logger",
@@ -21,55 +44,104 @@ var LogCmd = &cobra.Command{
 }
 
 func init() {
+	addSensorFlags(LogCmd)
+	addSinkFlags(LogCmd)
+
+	RootCmd.AddCommand(LogCmd)
+}
+
+// addSensorFlags registers the flags of the sensor devices and of the gnss
+// file replay.
+func addSensorFlags(cmd *cobra.Command) {
 	// Imu
-	LogCmd.Flags().String("imu-config-file", "imu-logger.json", "Imu logger config file. Default path is ./imu-logger.json")
-	LogCmd.Flags().String("imu-axis-map", "CamX:Z,CamY:X,CamZ:Y", "axis mapping of camera x,y,z values to real world x,y,z values. Default value is HDC mappings")
-	LogCmd.Flags().String("imu-inverted", "X:false,Y:false,Z:false", "axis inverted mapping of x,y,z values")
-	LogCmd.Flags().Bool("imu-skip-power-management", false, "skip power management setup of imu device on HDC-S")
+	cmd.Flags().String("imu-config-file", "imu-logger.json", "Imu logger config file. Default path is ./imu-logger.json")
+	cmd.Flags().String("imu-axis-map", "CamX:Z,CamY:X,CamZ:Y", "axis mapping of camera x,y,z values to real world x,y,z values. Default value is HDC mappings")
+	cmd.Flags().String("imu-inverted", "X:false,Y:false,Z:false", "axis inverted mapping of x,y,z values")
+	cmd.Flags().String("imu-dev-path", "/dev/spidev0.0", "Config serial location")
+	cmd.Flags().Bool("imu-skip-power-management", false, "skip power management setup of imu device on HDC-S")
 
 	// Gnss
-	LogCmd.Flags().Int("gnss-initial-baud-rate", 38400, "initial baud rate of gnss device")
-	LogCmd.Flags().String("gnss-config-file", "gnss-logger.json", "Neom9n logger config file. Default path is ./gnss-logger.json")
-	LogCmd.Flags().String("gnss-dev-path", "/dev/ttyAMA1", "Config serial location")
-	LogCmd.Flags().String("gnss-mga-offline-file-path", "/mnt/data/mgaoffline.ubx", "path to mga offline files")
-	LogCmd.Flags().Bool("gnss-fix-check", true, "check if gnss fix is set")
-	LogCmd.Flags().Bool("gnss-measx-enabled", false, "enable output of MEASX messages")
+	cmd.Flags().Int("gnss-initial-baud-rate", 38400, "baud rate of the gnss device probed first")
+	cmd.Flags().String("gnss-config-file", "gnss-logger.json", "gnss receiver config profile file, or built-in profile: "+strings.Join(config.BuiltinNames(), ", ")+". The default profile is used when the file doesn't exist")
+	cmd.Flags().String("gnss-dev-path", "/dev/ttyAMA1", "gnss device: serial port path, pty:///dev/pts/N, tcp://host:port or file:///path/capture.ubx?speed=1")
+	cmd.Flags().String("gnss-mga-offline-file-path", "/mnt/data/mgaoffline.ubx", "path to mga offline files")
+	cmd.Flags().Bool("gnss-fix-check", true, "check if gnss fix is set")
+	cmd.Flags().Bool("gnss-measx-enabled", false, "enable output of MEASX messages")
+	cmd.Flags().String("gnss-public-key", "", "hex P-192 public key of the gnss receiver to verify the SEC-ECSIGN signatures, only their hash is verified when empty")
+	cmd.Flags().String("gnss-raw-capture-dir", "", "directory where every frame received from the gnss device is written to rotating .ubx files, empty to disable")
+	cmd.Flags().Int64("gnss-raw-capture-max-file-size", 64*1024*1024, "size in bytes of a .ubx capture file before a new one is started")
+	cmd.Flags().Int("gnss-raw-capture-max-files", 24, "number of .ubx capture files kept, the oldest are removed")
+	cmd.Flags().String("gnss-auth-chain-dir", "", "directory where every SEC-ECSIGN is recorded with the frames it signs, for export-auth, empty to disable")
+	cmd.Flags().Int64("gnss-auth-chain-max-file-size", 64*1024*1024, "size in bytes of a gnss auth chain file before a new one is started")
+	cmd.Flags().Int("gnss-auth-chain-max-files", 48, "number of gnss auth chain files kept, the oldest are removed")
+	cmd.Flags().String("gnss-ubx-file", "", "read the gnss frames from this .ubx file instead of the gnss device")
+	cmd.Flags().Float64("gnss-ubx-file-speed", 1, "speed factor of the .ubx file when it has receive times, 0 reads it as fast as possible")
+	cmd.Flags().String("time-valid-threshold", "resolved", "resolved, time or date")
+
+	//Image feed
+	cmd.Flags().String("images-folder", "/mnt/data/pic", "")
 
-	LogCmd.Flags().String("time-valid-threshold", "resolved", "resolved, time or date")
+	cmd.Flags().Bool("skip-filtering", false, "skip filtering of gnss data")
+
+	// Magnetometer
+	cmd.Flags().Bool("enable-magnetometer", false, "enable reading from magnetometer")
+
+	// Gnss file replay
+	cmd.Flags().String("redis-read-gnss-from-file", "", "read gnss protobuf from this replay file instead of sensors, binary or json lines")
+	cmd.Flags().Float64("replay-speed", 1, "speed factor of the gnss file replay, 0 replays as fast as possible")
+	cmd.Flags().Bool("replay-loop", false, "restart the gnss file replay once it reaches the end")
+	cmd.Flags().Duration("replay-start", 0, "skip the messages recorded before this offset of the gnss file replay")
+	cmd.Flags().Duration("replay-end", 0, "stop the gnss file replay at this offset, 0 to replay until the end")
+}
 
+// addSinkFlags registers the flags of the outputs the sensor records are
+// fanned out to.
+func addSinkFlags(cmd *cobra.Command) {
 	// Sqlite database
-	LogCmd.Flags().String("db-output-path", "/mnt/data/gnss.v1.1.0.db", "path to sqliteLogger database")
-	LogCmd.Flags().Duration("db-log-ttl", 12*time.Hour, "ttl of logs in database")
-	LogCmd.Flags().String("imu-dev-path", "/dev/spidev0.0", "Config serial location")
+	cmd.Flags().Bool("enable-sqlite-logs", false, "enable sqlite logging of imu, gnss and magnetometer data to db-output-path")
+	cmd.Flags().String("db-output-path", "/mnt/data/gnss.v1.1.0.db", "path to sqliteLogger database")
+	cmd.Flags().Duration("db-log-ttl", 12*time.Hour, "ttl of logs in database")
 
-	//Image feed
-	LogCmd.Flags().String("images-folder", "/mnt/data/pic", "")
+	// Json file
+	cmd.Flags().Bool("enable-json-logs", false, "enable logging of imu, gnss and magnetometer data as json lines to json-output-path")
+	cmd.Flags().String("json-output-path", "/mnt/data/datalogger.jsonl", "path to the json lines log file")
 
 	// Connect-go
-	LogCmd.Flags().String("listen-addr", ":9000", "address to listen on")
+	cmd.Flags().String("listen-addr", ":9000", "address to listen on for the connect-go event service, empty to disable")
 
 	// Http server
-	LogCmd.Flags().String("http-listen-addr", ":9001", "http server address to listen on")
-
-	LogCmd.Flags().Bool("skip-filtering", false, "skip filtering of gnss data")
-
-	// Magnetometer
-	LogCmd.Flags().Bool("enable-magnetometer", false, "enable reading from magnetometer")
+	cmd.Flags().String("http-listen-addr", ":9001", "http status server address to listen on, empty to disable")
 
 	// Redis
-	LogCmd.Flags().Bool("enable-redis-logs", false, "enable redis logging")
-	LogCmd.Flags().Int("max-redis-imu-entries", 5000, "max imu entries in redis")
-	LogCmd.Flags().Int("max-redis-mag-entries", 1000, "max mag entries in redis")
-	LogCmd.Flags().Int("max-redis-gnss-entries", 1000, "max gnss entries in redis")
-	LogCmd.Flags().Int("max-redis-gnss-auth-entries", 1000, "max gnss auth entries in redis")
-	LogCmd.Flags().Bool("redis-log-pbtxt", false, "enable logging sensor data into redis in pbtxt format")
-	LogCmd.Flags().String("redis-write-gnss-to-file", "", "write protobuf to file instead of redis")
-	LogCmd.Flags().String("redis-read-gnss-from-file", "", "read protobuf from file instead of sensors")
-
-	RootCmd.AddCommand(LogCmd)
+	cmd.Flags().Bool("enable-redis-logs", false, "enable redis logging")
+	cmd.Flags().String("redis-addr", logger.DefaultRedisAddress, "redis server address, host:port or unix:///path/to/redis.sock")
+	cmd.Flags().String("redis-password", "", "redis password, defaults to the REDIS_PASSWORD environment variable")
+	cmd.Flags().Int("redis-db", 0, "redis database number")
+	cmd.Flags().Bool("redis-tls", false, "connect to redis over tls")
+	cmd.Flags().String("redis-tls-ca-file", "", "ca certificate used to verify the redis server, system pool if empty")
+	cmd.Flags().String("redis-key-prefix", "", "prefix added to every redis key, e.g. 'bench1:'")
+	cmd.Flags().Bool("redis-streams", false, "write records to redis streams with XADD instead of lists")
+	cmd.Flags().Int("redis-batch-size", 0, "pack imu and magnetometer samples by this many into ImuDataBatch and MagnetometerDataBatch entries, 0 to write every sample")
+	cmd.Flags().Duration("redis-batch-interval", 250*time.Millisecond, "max age of the oldest sample of a partial imu or magnetometer batch before it is written")
+	cmd.Flags().String("redis-spool-dir", "", "directory where records are spooled while redis is unreachable, empty to disable")
+	cmd.Flags().Int64("redis-spool-max-size", 256*1024*1024, "max size in bytes of the redis spool, the oldest records are dropped when full")
+	cmd.Flags().Duration("redis-stream-retention", 10*time.Minute, "age of the oldest entry kept in the redis streams, 0 to keep max-redis-*-entries entries instead")
+	cmd.Flags().Int("max-redis-imu-entries", 5000, "max imu entries in redis")
+	cmd.Flags().Int("max-redis-mag-entries", 1000, "max mag entries in redis")
+	cmd.Flags().Int("max-redis-gnss-entries", 1000, "max gnss entries in redis")
+	cmd.Flags().Int("max-redis-gnss-auth-entries", 1000, "max gnss auth entries in redis")
+	cmd.Flags().Bool("redis-log-pbtxt", false, "enable logging sensor data into redis in pbtxt format")
+	cmd.Flags().Bool("redis-gnss-epochs", false, "also write the navigation messages sharing an iTOW as one GnssEpoch entry, complete when every message of the gnss config profile arrived")
+	cmd.Flags().String("redis-write-gnss-to-file", "", "write gnss protobuf to this replay file instead of redis, json lines of pbtxt when it ends with .jsonl")
 }
 
 func logRun(cmd *cobra.Command, _ []string) error {
+	return runLogger(cmd, nil)
+}
+
+// runLogger reads the sensors until SIGINT or SIGTERM. When recorder isn't
+// nil every sensor input is also written to the recording session.
+func runLogger(cmd *cobra.Command, recorder *recording.Writer) error {
 	redisWriteGnssToFile := mustGetString(cmd, "redis-write-gnss-to-file")
 	redisReadGnssFromFile := mustGetString(cmd, "redis-read-gnss-from-file")
 	enableRedisLogs := getBoolOrDefault(cmd, "enable-redis-logs")
@@ -79,10 +151,23 @@ func logRun(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("enable-redis-logs must be set if either gnss-file flag is set")
 	}
 
-	if redisWriteGnssToFile != "" && !redisLogPbtxt {
-		return fmt.Errorf("redis-log-pbtxt must be set if redis-write-gnss-to-file is set")
+	if logger.IsJsonReplayFile(redisWriteGnssToFile) && !redisLogPbtxt {
+		return fmt.Errorf("redis-log-pbtxt must be set if redis-write-gnss-to-file is a json lines file")
+	}
+
+	err := session.SetSession("")
+	if err != nil {
+		return fmt.Errorf("setting session: %w", err)
 	}
 
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	ctx, fail := context.WithCancelCause(ctx)
+	defer fail(nil)
+
+	state := httpapi.NewState()
+	httpServer, eventServer := startServers(cmd, state, fail)
+
 	axisMap, err := parseAxisMap(mustGetString(cmd, "imu-axis-map"))
 	if err != nil {
 		return fmt.Errorf("parsing axis map: %w", err)
@@ -95,6 +180,15 @@ func logRun(cmd *cobra.Command, _ []string) error {
 
 	axisMap.SetInvertedAxes(invX, invY, invZ)
 
+	gnssProfile, err := loadGnssProfile(mustGetString(cmd, "gnss-config-file"))
+	if err != nil {
+		return err
+	}
+	gnssPublicKey, err := loadGnssPublicKey(mustGetString(cmd, "gnss-public-key"))
+	if err != nil {
+		return err
+	}
+
 	imuDevice := iim42652.NewSpi(
 		mustGetString(cmd, "imu-dev-path"),
 		iim42652.AccelerationSensitivityG4,
@@ -103,85 +197,374 @@ func logRun(cmd *cobra.Command, _ []string) error {
 		mustGetBool(cmd, "imu-skip-power-management"),
 	)
 
-	err = imuDevice.Init()
-	if err != nil {
-		return fmt.Errorf("initializing IMU: %w", err)
-	}
-	err = imuDevice.UpdateRegister(iim42652.RegisterAccelConfig, func(currentValue byte) byte {
-		return currentValue | 0x01
-	})
+	err = initImuDevice(imuDevice)
+	state.SetDeviceState("imu", err)
 	if err != nil {
-		return fmt.Errorf("failed to update register: %w", err)
+		return err
 	}
 	conf := imu.LoadConfig(mustGetString(cmd, "imu-config-file"))
 	fmt.Println("Config: ", conf.String())
 
-	dataHandler, err := NewDataHandler(
-		enableRedisLogs,
-		getIntOrDefault(cmd, "max-redis-imu-entries"),
-		getIntOrDefault(cmd, "max-redis-mag-entries"),
-		getIntOrDefault(cmd, "max-redis-gnss-entries"),
-		getIntOrDefault(cmd, "max-redis-gnss-auth-entries"),
-		redisLogPbtxt,
-		redisWriteGnssToFile,
-	)
+	sinks, redisLogger, err := newSinks(cmd, gnssProfile, state, eventServer)
 	if err != nil {
-		return fmt.Errorf("creating data handler: %w", err)
+		return err
+	}
+
+	dataHandler := NewDataHandler(sinks, redisLogger, eventServer)
+	dataHandler.recorder = recorder
+	if dir := mustGetString(cmd, "gnss-auth-chain-dir"); dir != "" {
+		authChain := authchain.NewRecorder(dir, mustGetInt64(cmd, "gnss-auth-chain-max-file-size"), mustGetInt(cmd, "gnss-auth-chain-max-files"))
+		defer func() {
+			if err := authChain.Close(); err != nil {
+				fmt.Println("closing gnss auth chain:", err)
+			}
+		}()
+		dataHandler.authChain = authChain
 	}
 
+	gnssOptions, rawCapture := gnssOptions(cmd, gnssProfile, gnssPublicKey)
+	if rawCapture != nil {
+		defer func() {
+			if err := rawCapture.Close(); err != nil {
+				fmt.Println("closing gnss raw capture:", err)
+			}
+		}()
+	}
+
+	feedSupervisor := supervisor.New(feedRestartInitialBackoff, feedRestartMaxBackoff, state.RecordRestart)
 	err = initializeSensorThreads(
+		ctx,
+		feedSupervisor,
 		imuDevice,
 		dataHandler,
+		state,
 		axisMap,
 		mustGetString(cmd, "gnss-dev-path"),
 		mustGetString(cmd, "gnss-mga-offline-file-path"),
 		mustGetInt(cmd, "gnss-initial-baud-rate"),
 		mustGetBool(cmd, "gnss-measx-enabled"),
+		gnssOptions,
 		mustGetBool(cmd, "enable-magnetometer"),
 		mustGetBool(cmd, "skip-filtering"),
 		redisReadGnssFromFile,
+		replayOptions(cmd),
 	)
 	if err != nil {
+		stop()
+		feedSupervisor.Wait()
+		_ = imuDevice.Close()
+		_ = dataHandler.Close()
+		return err
+	}
+
+	<-ctx.Done()
+	fmt.Println("Shutting down data logger")
+
+	feedsDone := make(chan struct{})
+	go func() {
+		feedSupervisor.Wait()
+		close(feedsDone)
+	}()
+	select {
+	case <-feedsDone:
+	case <-time.After(shutdownTimeout):
+		fmt.Println("timed out waiting for sensor feeds to stop")
+	}
+
+	if err := imuDevice.Close(); err != nil {
+		fmt.Println("closing imu:", err)
+	}
+	if err := dataHandler.Close(); err != nil {
+		fmt.Println("closing sinks:", err)
+	}
+	shutdownHttpServer(httpServer)
+
+	if err := context.Cause(ctx); !errors.Is(err, context.Canceled) {
 		return err
 	}
+	fmt.Println("Data logger stopped")
+	return nil
+}
+
+// startServers starts the http status server and the connect-go event
+// server, either is nil when its listen address is empty. A server that
+// fails, e.g. on a port already in use, calls fail with the reason.
+func startServers(cmd *cobra.Command, state *httpapi.State, fail context.CancelCauseFunc) (*httpapi.Server, *webconnect.EventServer) {
+	var httpServer *httpapi.Server
+	if httpListenAddr := mustGetString(cmd, "http-listen-addr"); httpListenAddr != "" {
+		httpServer = httpapi.NewServer(state)
+		go func() {
+			err := httpServer.Start(httpListenAddr)
+			if err != nil {
+				fail(fmt.Errorf("running http server: %w", err))
+			}
+		}()
+	}
+
+	var eventServer *webconnect.EventServer
+	if listenAddr := mustGetString(cmd, "listen-addr"); listenAddr != "" {
+		eventServer = webconnect.NewEventServer()
+		go func() {
+			err := eventServer.Start(listenAddr)
+			if err != nil {
+				fail(fmt.Errorf("running event server: %w", err))
+			}
+		}()
+	}
+	return httpServer, eventServer
+}
+
+func shutdownHttpServer(httpServer *httpapi.Server) {
+	if httpServer == nil {
+		return
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		fmt.Println("shutting down http server:", err)
+	}
+}
+
+// newSinks initializes every sink enabled by the flags. The redis logger is
+// also returned on its own, it is nil when redis logs are disabled. The gnss
+// epochs expect the messages of gnssProfile, of the default profile when it's
+// nil as for replays.
+func newSinks(cmd *cobra.Command, gnssProfile *config.Profile, state *httpapi.State, eventServer *webconnect.EventServer) (logger.Sinks, *logger.Redis, error) {
+	var sinks logger.Sinks
+	var redisLogger *logger.Redis
+	if getBoolOrDefault(cmd, "enable-redis-logs") {
+		options, err := redisOptions(cmd, gnssProfile)
+		if err != nil {
+			return nil, nil, err
+		}
+		redisLogger = logger.NewRedis(
+			getIntOrDefault(cmd, "max-redis-imu-entries"),
+			getIntOrDefault(cmd, "max-redis-mag-entries"),
+			getIntOrDefault(cmd, "max-redis-gnss-entries"),
+			getIntOrDefault(cmd, "max-redis-gnss-auth-entries"),
+			getBoolOrDefault(cmd, "redis-log-pbtxt"),
+			mustGetString(cmd, "redis-write-gnss-to-file"),
+			options...,
+		)
+		err = redisLogger.Init()
+		state.SetDeviceState("redis", err)
+		if err != nil {
+			return nil, nil, fmt.Errorf("initializing redis logger: %w", err)
+		}
+		sinks = append(sinks, redisLogger)
+	}
+
+	if mustGetBool(cmd, "enable-sqlite-logs") {
+		sqlite, err := newSqliteSink(mustGetString(cmd, "db-output-path"), mustGetDuration(cmd, "db-log-ttl"))
+		state.SetDeviceState("sqlite", err)
+		if err != nil {
+			_ = sinks.Close()
+			return nil, nil, fmt.Errorf("initializing sqlite logger: %w", err)
+		}
+		sinks = append(sinks, sqlite)
+	}
+
+	if mustGetBool(cmd, "enable-json-logs") {
+		jsonFile := logger.NewJsonFile(mustGetString(cmd, "json-output-path"))
+		err := jsonFile.Init()
+		if err != nil {
+			_ = sinks.Close()
+			return nil, nil, fmt.Errorf("initializing json file logger: %w", err)
+		}
+		sinks = append(sinks, jsonFile)
+	}
+
+	if eventServer != nil {
+		sinks = append(sinks, eventServer)
+	}
+	sinks = append(sinks, state)
+	return sinks, redisLogger, nil
+}
+
+// loadGnssProfile loads the gnss config profile, like the imu config the
+// default one is used when the file is missing
+func loadGnssProfile(nameOrPath string) (*config.Profile, error) {
+	profile, err := config.Load(nameOrPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		fmt.Printf("can't read %s, using the default gnss config profile\n", nameOrPath)
+		return config.Default(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	fmt.Println("Gnss config profile:", profile.Name)
+	return profile, nil
+}
+
+// loadGnssPublicKey parses the public key of the receiver, nil when it isn't
+// configured
+func loadGnssPublicKey(publicKey string) (*ecdsa.PublicKey, error) {
+	if publicKey == "" {
+		return nil, nil
+	}
+	return message.ParsePublicKey(publicKey)
+}
+
+// gnssOptions returns the options of the gnss device, and the raw capture
+// the device writes to when enabled.
+func gnssOptions(cmd *cobra.Command, profile *config.Profile, publicKey *ecdsa.PublicKey) ([]neom9n.Option, *message.RawCapture) {
+	options := []neom9n.Option{neom9n.WithConfigProfile(profile)}
+	if publicKey != nil {
+		options = append(options, neom9n.WithPublicKey(publicKey))
+	}
+	var rawCapture *message.RawCapture
+	if dir := mustGetString(cmd, "gnss-raw-capture-dir"); dir != "" {
+		rawCapture = message.NewRawCapture(dir, mustGetInt64(cmd, "gnss-raw-capture-max-file-size"), mustGetInt(cmd, "gnss-raw-capture-max-files"))
+		options = append(options, neom9n.WithRawCapture(rawCapture))
+	}
+	if path := mustGetString(cmd, "gnss-ubx-file"); path != "" {
+		options = append(options, neom9n.WithUbxFile(path, mustGetFloat64(cmd, "gnss-ubx-file-speed")))
+	}
+	return options, rawCapture
+}
+
+func replayOptions(cmd *cobra.Command) []gnss.ReplayOption {
+	options := []gnss.ReplayOption{
+		gnss.WithReplaySpeed(mustGetFloat64(cmd, "replay-speed")),
+		gnss.WithReplayRange(mustGetDuration(cmd, "replay-start"), mustGetDuration(cmd, "replay-end")),
+	}
+	if mustGetBool(cmd, "replay-loop") {
+		options = append(options, gnss.WithReplayLoop())
+	}
+	return options
+}
+
+func redisOptions(cmd *cobra.Command, gnssProfile *config.Profile) ([]logger.RedisOption, error) {
+	password := mustGetString(cmd, "redis-password")
+	if password == "" {
+		// keeps the password out of the process list
+		password = os.Getenv("REDIS_PASSWORD")
+	}
+
+	options := []logger.RedisOption{
+		logger.WithRedisAddress(mustGetString(cmd, "redis-addr")),
+		logger.WithRedisPassword(password),
+		logger.WithRedisDB(mustGetInt(cmd, "redis-db")),
+		logger.WithRedisKeyPrefix(mustGetString(cmd, "redis-key-prefix")),
+	}
+	if mustGetBool(cmd, "redis-tls") {
+		options = append(options, logger.WithRedisTLS(mustGetString(cmd, "redis-tls-ca-file")))
+	}
+	if batchSize := mustGetInt(cmd, "redis-batch-size"); batchSize > 0 {
+		options = append(options, logger.WithRedisBatching(batchSize, mustGetDuration(cmd, "redis-batch-interval")))
+	}
+	if spoolDir := mustGetString(cmd, "redis-spool-dir"); spoolDir != "" {
+		options = append(options, logger.WithRedisSpool(spoolDir, mustGetInt64(cmd, "redis-spool-max-size")))
+	}
+	if mustGetBool(cmd, "redis-streams") {
+		options = append(options, logger.WithRedisStreams(mustGetDuration(cmd, "redis-stream-retention")))
+	}
+	if mustGetBool(cmd, "redis-gnss-epochs") {
+		expected := neom9n.DefaultEpochMessages
+		if gnssProfile != nil {
+			items, err := gnssProfile.Items()
+			if err != nil {
+				return nil, err
+			}
+			expected = neom9n.ExpectedEpochMessages(items)
+		}
+		options = append(options, logger.WithRedisGnssEpochs(expected))
+	}
+	return options, nil
+}
+
+func initImuDevice(imuDevice *iim42652.IIM42652) error {
+	err := imuDevice.Init()
+	if err != nil {
+		return fmt.Errorf("initializing IMU: %w", err)
+	}
+	err = imuDevice.UpdateRegister(iim42652.RegisterAccelConfig, func(currentValue byte) byte {
+		return currentValue | 0x01
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update register: %w", err)
+	}
+	return nil
+}
 
-	for {
-		time.Sleep(10 * time.Second)
+// rawImuHandlers returns the handlers of the raw imu samples, the derived
+// acceleration events are only computed when someone can consume them.
+func rawImuHandlers(dataHandler *DataHandler) []imu.RawFeedHandler {
+	handlers := []imu.RawFeedHandler{dataHandler.HandleRawImuFeed}
+	if dataHandler.eventServer != nil {
+		orientedFeed := imu.NewOrientedAccelerationFeed(dataHandler.HandleOrientedAcceleration)
+		tiltCorrectedFeed := imu.NewTiltCorrectedAccelerationFeed(orientedFeed.HandleTiltCorrectedAcceleration)
+		handlers = append(handlers, func(acceleration *imu.Acceleration, angularRate *iim42652.AngularRate, temperature iim42652.Temperature, _ *iim42652.Fsync) error {
+			return tiltCorrectedFeed.HandleRawFeed(acceleration, angularRate, temperature)
+		})
 	}
+	return handlers
 }
 
+// initializeSensorThreads starts every sensor feed under the supervisor, a
+// failed feed gets its device re-initialized and restarted until ctx is
+// cancelled.
 func initializeSensorThreads(
+	ctx context.Context,
+	feedSupervisor *supervisor.Supervisor,
 	imuDevice *iim42652.IIM42652,
 	dataHandler *DataHandler,
+	state *httpapi.State,
 	axisMap *iim42652.AxisMap,
 	gnssDevPath string,
 	mgaOfflineFilePath string,
 	gnssInitBaudRate int,
 	gnssMeasxEnabled bool,
+	gnssOptions []neom9n.Option,
 	enableMagnetometer bool,
 	skipFiltering bool,
 	gnssReadFile string,
+	gnssReplayOptions []gnss.ReplayOption,
 ) error {
 	var err error
 
 	rawImuEventFeed := imu.NewRawFeed(
 		imuDevice,
-		dataHandler.HandleRawImuFeed,
+		rawImuHandlers(dataHandler)...,
 	)
-	go func() {
-		err := rawImuEventFeed.Run(axisMap)
-		if err != nil {
-			panic(fmt.Errorf("running raw imu event feed: %w", err))
+	imuInitialized := true
+	feedSupervisor.Go(ctx, "imu", func(ctx context.Context) error {
+		if !imuInitialized {
+			_ = imuDevice.Close()
+			err := initImuDevice(imuDevice)
+			state.SetDeviceState("imu", err)
+			if err != nil {
+				return err
+			}
 		}
-	}()
+		imuInitialized = false
+		return rawImuEventFeed.Run(ctx, axisMap)
+	})
 
 	if gnssReadFile == "" {
-		gnssDevice := neom9n.NewNeom9n(gnssDevPath, mgaOfflineFilePath, gnssInitBaudRate, gnssMeasxEnabled)
-		err = gnssDevice.Init(nil)
-		if err != nil {
-			return fmt.Errorf("initializing neom9n: %w", err)
+		newGnssDevice := func() (*neom9n.Neom9n, error) {
+			gnssTransport, err := transport.Parse(gnssDevPath, gnssInitBaudRate)
+			if err != nil {
+				return nil, err
+			}
+			// a --gnss-ubx-file transport in gnssOptions replaces the device one
+			options := append([]neom9n.Option{neom9n.WithTransport(gnssTransport)}, gnssOptions...)
+			gnssDevice := neom9n.NewNeom9n(gnssDevPath, mgaOfflineFilePath, gnssInitBaudRate, gnssMeasxEnabled, options...)
+			err = gnssDevice.Init(nil)
+			state.SetDeviceState("gnss", err)
+			if err != nil {
+				return nil, fmt.Errorf("initializing neom9n: %w", err)
+			}
+			if result := gnssDevice.ConfigResult(); result != nil {
+				state.SetGnssConfig(result)
+			}
+			return gnssDevice, nil
 		}
 
+		// created by the feed, the first attempt gets the backoff of the
+		// restarts when the receiver isn't there yet
+		var gnssDevice *neom9n.Neom9n
+
 		var options []gnss.Option
 		if skipFiltering {
 			options = append(options, gnss.WithSkipFiltering())
@@ -194,22 +577,33 @@ func initializeSensorThreads(
 			options...,
 		)
 
-		go func() {
-			err = gnssEventFeed.Run(gnssDevice, dataHandler.redisLogger, dataHandler.redisLogsEnabled)
-			if err != nil {
-				panic(fmt.Errorf("running gnss event feed: %w", err))
+		feedSupervisor.Go(ctx, "gnss", func(ctx context.Context) error {
+			if gnssDevice == nil {
+				device, err := newGnssDevice()
+				if err != nil {
+					return err
+				}
+				gnssDevice = device
 			}
-		}()
+			defer func() {
+				if err := gnssDevice.Close(); err != nil {
+					fmt.Println("closing gnss device:", err)
+				}
+				gnssDevice = nil
+			}()
+			return gnssEventFeed.Run(ctx, gnssDevice, dataHandler)
+		})
 	} else {
 		gnssReplayFeed := gnss.NewGnssReplayFeed(
 			gnssReadFile,
 			dataHandler.HandleGnssReplayData,
+			gnssReplayOptions...,
 		)
 
 		go func() {
-			err = gnssReplayFeed.Run()
+			err := gnssReplayFeed.Run(ctx)
 			if err != nil {
-				panic(fmt.Errorf("running gnss event feed: %w", err))
+				fmt.Println("running gnss replay feed:", err)
 			}
 		}()
 	}
@@ -220,15 +614,29 @@ func initializeSensorThreads(
 		)
 
 		err = magnetometerEventFeed.Init()
+		state.SetDeviceState("magnetometer", err)
 		if err != nil {
-			panic(fmt.Errorf("initializing magnetometer feed: %w", err))
+			return fmt.Errorf("initializing magnetometer feed: %w", err)
 		}
-		go func() {
-			err = magnetometerEventFeed.Run()
-			if err != nil {
-				panic(fmt.Errorf("running magnetometer feed: %w", err))
+
+		magnetometerInitialized := true
+		feedSupervisor.Go(ctx, "magnetometer", func(ctx context.Context) error {
+			if !magnetometerInitialized {
+				_ = magnetometerEventFeed.Close()
+				err := magnetometerEventFeed.Init()
+				state.SetDeviceState("magnetometer", err)
+				if err != nil {
+					return err
+				}
 			}
-		}()
+			magnetometerInitialized = false
+			defer func() {
+				if ctx.Err() != nil {
+					_ = magnetometerEventFeed.Close()
+				}
+			}()
+			return magnetometerEventFeed.Run(ctx)
+		})
 	}
 	return nil
 }
@@ -1,9 +1,14 @@
 package main
 
 import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Hivemapper/gnss-controller/config"
 	"github.com/streamingfast/imu-controller/device/iim42652"
 	"github.com/stretchr/testify/assert"
-	"testing"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_ParseAxisMap(t *testing.T) {
@@ -113,3 +118,15 @@ func Test_ParseInvertedAxes(t *testing.T) {
 		})
 	}
 }
+
+func Test_LoadGnssProfile(t *testing.T) {
+	dir := t.TempDir()
+	profile, err := loadGnssProfile(filepath.Join(dir, "missing.json"))
+	require.NoError(t, err)
+	assert.Equal(t, config.DefaultProfile, profile.Name, "the default profile is used when the file is missing")
+
+	invalid := filepath.Join(dir, "invalid.json")
+	require.NoError(t, os.WriteFile(invalid, []byte("{"), 0644))
+	_, err = loadGnssProfile(invalid)
+	assert.Error(t, err, "an invalid file isn't replaced by the default profile")
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/Hivemapper/hivemapper-data-logger/recording"
+	"github.com/spf13/cobra"
+)
+
+var RecordCmd = &cobra.Command{
+	Use:   "record",
+	Short: "Run the data logger and record every sensor input into a session file",
+	Long: `Runs the data logger like the log command and also writes the imu,
+magnetometer, ubx and gnss inputs into a session file, ordered by time. The
+session file can be played back with the replay command.`,
+	RunE: recordRun,
+}
+
+func init() {
+	addSensorFlags(RecordCmd)
+	addSinkFlags(RecordCmd)
+	RecordCmd.Flags().String("session-file", "/mnt/data/session.jsonl", "path of the recorded session file")
+
+	RootCmd.AddCommand(RecordCmd)
+}
+
+func recordRun(cmd *cobra.Command, _ []string) error {
+	if mustGetString(cmd, "redis-read-gnss-from-file") != "" {
+		return fmt.Errorf("redis-read-gnss-from-file can't be recorded, record the gnss device instead")
+	}
+
+	sessionFile := mustGetString(cmd, "session-file")
+	recorder := recording.NewWriter(sessionFile)
+	err := recorder.Open()
+	if err != nil {
+		return fmt.Errorf("opening session recording: %w", err)
+	}
+	fmt.Println("Recording session to", sessionFile)
+
+	return runLogger(cmd, recorder)
+}
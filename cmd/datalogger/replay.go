@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/device/neom9n"
+	"github.com/Hivemapper/hivemapper-data-logger/data/imu"
+	"github.com/Hivemapper/hivemapper-data-logger/data/session"
+	"github.com/Hivemapper/hivemapper-data-logger/httpapi"
+	"github.com/Hivemapper/hivemapper-data-logger/recording"
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/imu-controller/device/iim42652"
+)
+
+var ReplayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "Play a recorded session back through the data logger outputs",
+	Long: `Plays a session file written by the record command through the same
+handlers as the log command, keeping the recorded timing between the records.
+Timestamps are shifted to the time of the replay.`,
+	RunE: replayRun,
+}
+
+func init() {
+	addSinkFlags(ReplayCmd)
+	ReplayCmd.Flags().String("session-file", "/mnt/data/session.jsonl", "path of the session file to replay")
+	ReplayCmd.Flags().Float64("speed", 1, "speed factor of the replay, 0 replays as fast as possible")
+	ReplayCmd.Flags().Bool("loop", false, "restart the replay once it reaches the end of the session")
+
+	RootCmd.AddCommand(ReplayCmd)
+}
+
+func replayRun(cmd *cobra.Command, _ []string) error {
+	err := session.SetSession("")
+	if err != nil {
+		return fmt.Errorf("setting session: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	ctx, fail := context.WithCancelCause(ctx)
+	defer fail(nil)
+
+	state := httpapi.NewState()
+	httpServer, eventServer := startServers(cmd, state, fail)
+	defer shutdownHttpServer(httpServer)
+
+	sinks, redisLogger, err := newSinks(cmd, nil, state, eventServer)
+	if err != nil {
+		return err
+	}
+	dataHandler := NewDataHandler(sinks, redisLogger, eventServer)
+	defer func() {
+		if err := dataHandler.Close(); err != nil {
+			fmt.Println("closing sinks:", err)
+		}
+	}()
+
+	options := []recording.PlayerOption{recording.WithSpeed(mustGetFloat64(cmd, "speed"))}
+	if mustGetBool(cmd, "loop") {
+		options = append(options, recording.WithLoop())
+	}
+
+	sessionFile := mustGetString(cmd, "session-file")
+	player := recording.NewPlayer(sessionFile, newReplayHandler(dataHandler), options...)
+
+	fmt.Println("Replaying session", sessionFile)
+	err = player.Run(ctx)
+	if err != nil {
+		return fmt.Errorf("replaying session: %w", err)
+	}
+	if err := context.Cause(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+	fmt.Println("Session replay done")
+	return nil
+}
+
+// replayHandler feeds the replayed records to the DataHandler the way the
+// sensor feeds of the log command do.
+type replayHandler struct {
+	dataHandler *DataHandler
+	imuHandlers []imu.RawFeedHandler
+}
+
+func newReplayHandler(dataHandler *DataHandler) *replayHandler {
+	return &replayHandler{
+		dataHandler: dataHandler,
+		imuHandlers: rawImuHandlers(dataHandler),
+	}
+}
+
+func (h *replayHandler) HandleImu(acceleration *imu.Acceleration, angularRate *iim42652.AngularRate, temperature iim42652.Temperature, fsync *iim42652.Fsync) error {
+	for _, handler := range h.imuHandlers {
+		if err := handler(acceleration, angularRate, temperature, fsync); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *replayHandler) HandleMagnetometer(systemTime time.Time, x float64, y float64, z float64) error {
+	return h.dataHandler.HandlerMagnetometerData(systemTime, x, y, z)
+}
+
+func (h *replayHandler) HandleUbxMessage(msg interface{}) error {
+	return h.dataHandler.HandleUbxMessage(msg)
+}
+
+func (h *replayHandler) HandleGnssData(data *neom9n.Data) error {
+	return h.dataHandler.HandlerGnssData(data)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/device/neom9n"
+	"github.com/Hivemapper/hivemapper-data-logger/data/gnss"
+	"github.com/Hivemapper/hivemapper-data-logger/data/imu"
+	"github.com/Hivemapper/hivemapper-data-logger/data/magnetometer"
+	"github.com/Hivemapper/hivemapper-data-logger/logger"
+	"github.com/daedaleanai/ublox/ubx"
+)
+
+// sqliteSink adapts the generic sqlite logger to the logger.Sink interface,
+// the table wrappers live in the data packages which the logger package
+// can't depend on.
+type sqliteSink struct {
+	sqlite *logger.Sqlite
+}
+
+func newSqliteSink(dbOutputPath string, dbLogTTL time.Duration) (*sqliteSink, error) {
+	sqlite := logger.NewSqlite(
+		dbOutputPath,
+		[]logger.CreateTableQueryFunc{imu.CreateTableQuery, gnss.CreateTableQuery, magnetometer.CreateTableQuery},
+		[]logger.AlterTableQueryFunc{imu.AlterTableQuery, gnss.AlterTableQuery, magnetometer.AlterTableQuery},
+		[]logger.PurgeQueryFunc{imu.PurgeQuery, gnss.PurgeQuery, magnetometer.PurgeQuery},
+	)
+	err := sqlite.Init(dbLogTTL)
+	if err != nil {
+		return nil, err
+	}
+	return &sqliteSink{sqlite: sqlite}, nil
+}
+
+func (s *sqliteSink) LogImu(record *logger.ImuRecord) error {
+	return s.sqlite.Log(imu.NewImuSqlWrapper(record.SystemTime, record.Temperature, record.Acceleration, record.AngularRate, record.Fsync))
+}
+
+func (s *sqliteSink) LogMagnetometer(record *logger.MagnetometerRecord) error {
+	return s.sqlite.Log(magnetometer.NewMagnetometerSqlWrapper(record.SystemTime, record.X, record.Y, record.Z))
+}
+
+func (s *sqliteSink) HandleUbxMessage(msg interface{}) error {
+	if navPvt, ok := msg.(*ubx.NavPvt); ok {
+		return s.sqlite.Log(gnss.NewNavPvtSqlWrapper(time.Now().UTC(), navPvt))
+	}
+	return nil
+}
+
+func (s *sqliteSink) LogGnssAuth(_ *neom9n.Data) error {
+	return nil
+}
+
+func (s *sqliteSink) Close() error {
+	return s.sqlite.Close()
+}
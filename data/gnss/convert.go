@@ -0,0 +1,66 @@
+package gnss
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Hivemapper/hivemapper-data-logger/logger"
+	"github.com/Hivemapper/hivemapper-data-logger/logger/client"
+	"google.golang.org/protobuf/proto"
+)
+
+// ConvertJsonReplayFile converts a JSON lines gnss replay file into the
+// binary replay format with its index. The pbtxt messages are stored as
+// binary protobuf, the sensor time of a record is the system time of its
+// message, or of the previous message when it has none. It returns the
+// number of converted records.
+func ConvertJsonReplayFile(inputPath string, outputPath string) (int, error) {
+	source, err := openReplaySource(inputPath)
+	if err != nil {
+		return 0, err
+	}
+	defer source.Close()
+	if _, ok := source.(*jsonReplaySource); !ok {
+		return 0, fmt.Errorf("%s is not a json lines replay file", inputPath)
+	}
+
+	writer, err := logger.NewReplayFileWriter(outputPath)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	sensorTime := time.Unix(0, 0).UTC()
+	for {
+		redisKey, data, err := source.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			_ = writer.Close()
+			return count, err
+		}
+
+		msg, err := client.Decode(redisKey, data)
+		if err != nil {
+			fmt.Printf("skipping %s message: %s\n", redisKey, err)
+			continue
+		}
+		if systemTime, ok := messageSystemTime(msg); ok {
+			sensorTime = systemTime
+		}
+
+		binaryData, err := proto.Marshal(msg)
+		if err != nil {
+			_ = writer.Close()
+			return count, fmt.Errorf("marshalling %s message: %w", redisKey, err)
+		}
+		if err := writer.Write(redisKey, sensorTime, binaryData); err != nil {
+			_ = writer.Close()
+			return count, err
+		}
+		count++
+	}
+	return count, writer.Close()
+}
@@ -1,6 +1,7 @@
 package gnss
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -39,15 +40,25 @@ func WithSkipFiltering() func(*GnssFeed) {
 	}
 }
 
-func (f *GnssFeed) Run(gnssDevice *neom9n.Neom9n, redisFeed message.UbxMessageHandler, redisLogsEnabled bool) error {
+// Run feeds the gnss device data to the handlers until ctx is cancelled or
+// the device fails. The caller owns the device and must close it.
+func (f *GnssFeed) Run(ctx context.Context, gnssDevice *neom9n.Neom9n, ubxHandler message.UbxMessageHandler) error {
 	//todo: datafeed is ugly
 	dataFeed := neom9n.NewDataFeed(f.HandleData)
-	err := gnssDevice.Run(dataFeed, redisFeed, redisLogsEnabled)
-	if err != nil {
-		return fmt.Errorf("running gnss device: %w", err)
-	}
+	deviceDone := make(chan error, 1)
+	go func() {
+		deviceDone <- gnssDevice.Run(dataFeed, ubxHandler, ubxHandler != nil)
+	}()
 
-	return nil
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-deviceDone:
+		if err != nil {
+			return fmt.Errorf("running gnss device: %w", err)
+		}
+		return nil
+	}
 }
 
 func (f *GnssFeed) HandleData(d *neom9n.Data) {
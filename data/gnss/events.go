@@ -0,0 +1,40 @@
+package gnss
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/device/neom9n"
+	"github.com/Hivemapper/hivemapper-data-logger/data"
+	"github.com/daedaleanai/ublox/ubx"
+)
+
+type GnssEvent struct {
+	*data.BaseEvent
+}
+
+func NewGnssEvent(gnssData *neom9n.Data) *GnssEvent {
+	return &GnssEvent{
+		BaseEvent: data.NewBaseEvent("GNSS_EVENT", "GNSS", gnssData.SystemTime, gnssData),
+	}
+}
+
+func (e *GnssEvent) String() string {
+	return fmt.Sprintf("GnssEvent: %s", e.Time)
+}
+
+type NavPvtEvent struct {
+	*data.BaseEvent
+	NavPvt *ubx.NavPvt `json:"nav_pvt"`
+}
+
+func NewNavPvtEvent(systemTime time.Time, navPvt *ubx.NavPvt) *NavPvtEvent {
+	return &NavPvtEvent{
+		BaseEvent: data.NewBaseEvent("GNSS_NAV_PVT_EVENT", "GNSS", systemTime, nil),
+		NavPvt:    navPvt,
+	}
+}
+
+func (e *NavPvtEvent) String() string {
+	return fmt.Sprintf("NavPvtEvent: itow %d, lat %d, lon %d", e.NavPvt.ITOW_ms, e.NavPvt.Lat_dege7, e.NavPvt.Lon_dege7)
+}
@@ -1,96 +1,145 @@
 package gnss
 
 import (
-	"bufio"
-	"encoding/json"
+	"context"
 	"fmt"
 	"io"
-	"os"
 	"time"
 
 	"github.com/Hivemapper/hivemapper-data-logger/logger"
+	"github.com/Hivemapper/hivemapper-data-logger/logger/client"
 	sensordata "github.com/Hivemapper/hivemapper-data-logger/proto-out"
-	"google.golang.org/protobuf/encoding/prototext"
 	"google.golang.org/protobuf/proto"
 )
 
+// replaySeekMargin is how much before the start offset the replay starts
+// decoding, the index is on recorded system time and the offsets on gps time.
+const replaySeekMargin = 2 * time.Second
+
 type GnssReplayDataHandler func(redisKey string, data []byte) error
 
+type ReplayOption func(*GnssReplayFeed)
+
+// WithReplaySpeed plays the recording speed times faster than it was
+// recorded, a speed of 0 replays as fast as possible.
+func WithReplaySpeed(speed float64) ReplayOption {
+	return func(f *GnssReplayFeed) {
+		f.speed = speed
+	}
+}
+
+// WithReplayLoop restarts the replay from the start offset once the end is
+// reached, until the context is cancelled.
+func WithReplayLoop() ReplayOption {
+	return func(f *GnssReplayFeed) {
+		f.loop = true
+	}
+}
+
+// WithReplayRange only replays the messages recorded between start and end
+// after the first message, a zero end replays until the end of the file.
+func WithReplayRange(start time.Duration, end time.Duration) ReplayOption {
+	return func(f *GnssReplayFeed) {
+		f.start = start
+		f.end = end
+	}
+}
+
 type GnssReplayFeed struct {
 	replayFilePath string
 	dataHandler    GnssReplayDataHandler
+
+	speed float64
+	loop  bool
+	start time.Duration
+	end   time.Duration
 }
 
-func NewGnssReplayFeed(replayFilePath string, dataHandler GnssReplayDataHandler) *GnssReplayFeed {
+func NewGnssReplayFeed(replayFilePath string, dataHandler GnssReplayDataHandler, opts ...ReplayOption) *GnssReplayFeed {
 	g := &GnssReplayFeed{
 		replayFilePath: replayFilePath,
 		dataHandler:    dataHandler,
+		speed:          1,
 	}
+
+	for _, opt := range opts {
+		opt(g)
+	}
+
 	return g
 }
 
-// map rediskey to protobuf type
-var redisKeyToProto = map[string]proto.Message{
-	"NavStatus":  &sensordata.NavStatus{},
-	"NavDop":     &sensordata.NavDop{},
-	"MonRf":      &sensordata.MonRf{},
-	"RxmMeasx":   &sensordata.RxmMeasx{},
-	"RxmRawx":    &sensordata.RxmRawx{},
-	"RxmSfrbx":   &sensordata.RxmSfrbx{},
-	"TimTp":      &sensordata.TimTp{},
-	"NavCov":     &sensordata.NavCov{},
-	"NavPvt":     &sensordata.NavPvt{},
-	"NavPosecef": &sensordata.NavPosecef{},
-	"NavVelecef": &sensordata.NavVelecef{},
-	"NavSig":     &sensordata.NavSig{},
-	"NavTimegps": &sensordata.NavTimegps{},
+// Run replays the file until its end, or forever with WithReplayLoop,
+// keeping the recorded spacing between the messages.
+func (f *GnssReplayFeed) Run(ctx context.Context) error {
+	for {
+		err := f.replay(ctx)
+		if err != nil {
+			return err
+		}
+		if !f.loop || ctx.Err() != nil {
+			return nil
+		}
+		fmt.Println("looping gnss replay file")
+	}
 }
 
-func (f *GnssReplayFeed) Run() error {
-	replayFileHandle, err := os.Open(f.replayFilePath)
+func (f *GnssReplayFeed) replay(ctx context.Context) error {
+	source, err := openReplaySource(f.replayFilePath)
 	if err != nil {
-		return fmt.Errorf("opening gnss replay file: %w", err)
+		return err
+	}
+	defer func() {
+		_ = source.Close()
+	}()
+
+	clock := &replayClock{}
+	var wallStart time.Time
+	var startPosition int64 = -1
+
+	if binarySource, ok := source.(*binaryReplaySource); ok && f.start > replaySeekMargin {
+		// the index moves close to the start offset instead of decoding every message before it
+		skipped, err := binarySource.skipTo(f.start - replaySeekMargin)
+		if err != nil && err != io.EOF {
+			fmt.Println("seeking gnss replay file, replaying from the start:", err)
+			_ = source.Close()
+			if source, err = openReplaySource(f.replayFilePath); err != nil {
+				return err
+			}
+		}
+		clock.position = skipped.Milliseconds()
 	}
-	defer replayFileHandle.Close()
-	reader := bufio.NewReader(replayFileHandle)
-
-	firstEpoch := true
 
 	for {
-		line, err := reader.ReadString('\n')
+		redisKey, data, err := source.next()
 		if err != nil {
 			if err == io.EOF {
 				fmt.Println("done reading gnss replay file")
 				return nil
 			}
-			return fmt.Errorf("error reading from gnss replay file: %s", err)
+			return err
 		}
 
-		var entry logger.GnssReplayEvent
-		err = json.Unmarshal([]byte(line), &entry)
+		msg, err := client.Decode(redisKey, data)
 		if err != nil {
-			fmt.Printf("error unmarshalling gnss json line: %s\n", err)
+			fmt.Printf("error unmarshalling gnss data: %s\n", err)
 			continue
 		}
 
-		if entry.RedisKey == "RxmRawx" {
-			if !firstEpoch {
-				time.Sleep(250 * time.Millisecond)
-			}
-			firstEpoch = false
-		}
-
-		protoType, ok := redisKeyToProto[entry.RedisKey]
-		if !ok {
-			fmt.Printf("unknown redis key: %s\n", entry.RedisKey)
+		position := clock.place(msg)
+		if position < f.start.Milliseconds() {
 			continue
 		}
+		if f.end > 0 && position > f.end.Milliseconds() {
+			return nil
+		}
+		if startPosition < 0 {
+			startPosition = position
+			wallStart = time.Now()
+		}
 
-		msg := protoType
-		err = prototext.Unmarshal([]byte(entry.Data), msg)
-		if err != nil {
-			fmt.Printf("error unmarshalling gnss data pbtxt: %s\n", err)
-			continue
+		if !f.waitUntil(ctx, wallStart, time.Duration(position-startPosition)*time.Millisecond) {
+			return nil
 		}
 
 		// Monotonic time and system time in the replayed data needs to be updated
@@ -106,8 +155,30 @@ func (f *GnssReplayFeed) Run() error {
 			continue
 		}
 
-		if err := f.dataHandler(entry.RedisKey, binary_data); err != nil {
+		if err := f.dataHandler(redisKey, binary_data); err != nil {
 			fmt.Printf("error pushing gnss replay data to redis: %s\n", err)
 		}
 	}
 }
+
+// waitUntil sleeps until elapsed recording time, scaled by the replay speed,
+// has passed since wallStart. It returns false when ctx is cancelled.
+func (f *GnssReplayFeed) waitUntil(ctx context.Context, wallStart time.Time, elapsed time.Duration) bool {
+	if f.speed <= 0 {
+		return ctx.Err() == nil
+	}
+
+	wait := time.Until(wallStart.Add(time.Duration(float64(elapsed) / f.speed)))
+	if wait <= 0 {
+		return ctx.Err() == nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,198 @@
+package gnss
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Hivemapper/hivemapper-data-logger/logger"
+	sensordata "github.com/Hivemapper/hivemapper-data-logger/proto-out"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_ReplayClock(t *testing.T) {
+	tests := []struct {
+		name      string
+		messages  []proto.Message
+		positions []int64
+	}{
+		{
+			name: "itow spacing",
+			messages: []proto.Message{
+				&sensordata.NavPvt{ItowMs: 1000},
+				&sensordata.NavDop{ItowMs: 1000},
+				&sensordata.NavPvt{ItowMs: 1250},
+				&sensordata.NavPvt{ItowMs: 1500},
+			},
+			positions: []int64{0, 0, 250, 500},
+		},
+		{
+			name: "system time only messages follow the itow timeline",
+			messages: []proto.Message{
+				&sensordata.NavPvt{ItowMs: 1000, SystemTime: "2024-01-01 00:00:00.050 +0000 UTC"},
+				&sensordata.MonRf{SystemTime: "2024-01-01 00:00:00.150 +0000 UTC"},
+				&sensordata.NavPosecef{},
+				&sensordata.NavPvt{ItowMs: 1250, SystemTime: "2024-01-01 00:00:00.300 +0000 UTC"},
+			},
+			positions: []int64{0, 100, 100, 250},
+		},
+		{
+			name: "system time without any itow",
+			messages: []proto.Message{
+				&sensordata.MonRf{SystemTime: "2024-01-01 00:00:00 +0000 UTC m=+10.000000001"},
+				&sensordata.RxmSfrbx{SystemTime: "2024-01-01 00:00:01.5"},
+			},
+			positions: []int64{0, 1500},
+		},
+		{
+			name: "week rollover",
+			messages: []proto.Message{
+				&sensordata.NavPvt{ItowMs: msPerWeek - 250},
+				&sensordata.NavPvt{ItowMs: 0},
+				&sensordata.NavPvt{ItowMs: 250},
+			},
+			positions: []int64{0, 250, 500},
+		},
+		{
+			name: "never goes backwards",
+			messages: []proto.Message{
+				&sensordata.NavPvt{ItowMs: 2000},
+				&sensordata.RxmRawx{RcvTowS: 1.5},
+				&sensordata.RxmMeasx{GpsTowMs: 2500},
+			},
+			positions: []int64{0, 0, 500},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clock := &replayClock{}
+			var positions []int64
+			for _, msg := range test.messages {
+				positions = append(positions, clock.place(msg))
+			}
+			assert.Equal(t, test.positions, positions)
+		})
+	}
+}
+
+func writeReplayFile(t *testing.T, messages map[string][]proto.Message, keys []string) string {
+	path := filepath.Join(t.TempDir(), "gnss.jsonl")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	counters := map[string]int{}
+	for _, key := range keys {
+		data, err := prototext.Marshal(messages[key][counters[key]])
+		require.NoError(t, err)
+		counters[key]++
+		line, err := json.Marshal(logger.GnssReplayEvent{RedisKey: key, Data: string(data)})
+		require.NoError(t, err)
+		_, err = file.Write(append(line, '\n'))
+		require.NoError(t, err)
+	}
+	return path
+}
+
+func Test_GnssReplayRange(t *testing.T) {
+	var navPvts []proto.Message
+	var keys []string
+	for i := 0; i < 20; i++ {
+		navPvts = append(navPvts, &sensordata.NavPvt{ItowMs: uint32(1000 + i*250)})
+		keys = append(keys, "NavPvt")
+	}
+	path := writeReplayFile(t, map[string][]proto.Message{"NavPvt": navPvts}, keys)
+
+	var itows []uint32
+	feed := NewGnssReplayFeed(path, func(redisKey string, data []byte) error {
+		navPvt := &sensordata.NavPvt{}
+		require.NoError(t, proto.Unmarshal(data, navPvt))
+		itows = append(itows, navPvt.ItowMs)
+		return nil
+	}, WithReplaySpeed(0), WithReplayRange(time.Second, 2*time.Second))
+
+	require.NoError(t, feed.Run(context.Background()))
+	assert.Equal(t, []uint32{2000, 2250, 2500, 2750, 3000}, itows)
+}
+
+func Test_GnssReplaySpeed(t *testing.T) {
+	path := writeReplayFile(t, map[string][]proto.Message{
+		"NavPvt": {&sensordata.NavPvt{ItowMs: 1000}, &sensordata.NavPvt{ItowMs: 2000}},
+	}, []string{"NavPvt", "NavPvt"})
+
+	count := 0
+	feed := NewGnssReplayFeed(path, func(string, []byte) error {
+		count++
+		return nil
+	}, WithReplaySpeed(10))
+
+	start := time.Now()
+	require.NoError(t, feed.Run(context.Background()))
+	elapsed := time.Since(start)
+	assert.Equal(t, 2, count)
+	assert.GreaterOrEqual(t, elapsed, 100*time.Millisecond)
+	assert.Less(t, elapsed, 500*time.Millisecond)
+}
+
+func Test_GnssReplayLoopStopsOnCancel(t *testing.T) {
+	path := writeReplayFile(t, map[string][]proto.Message{
+		"NavPvt": {&sensordata.NavPvt{ItowMs: 1000}},
+	}, []string{"NavPvt"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	count := 0
+	feed := NewGnssReplayFeed(path, func(string, []byte) error {
+		count++
+		if count == 3 {
+			cancel()
+		}
+		return nil
+	}, WithReplaySpeed(0), WithReplayLoop())
+
+	require.NoError(t, feed.Run(ctx))
+	assert.Equal(t, 3, count)
+}
+
+func Test_GnssReplayBinaryFile(t *testing.T) {
+	var navPvts []proto.Message
+	var keys []string
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 40; i++ {
+		navPvts = append(navPvts, &sensordata.NavPvt{
+			ItowMs:     uint32(1000 + i*250),
+			SystemTime: start.Add(time.Duration(i) * 250 * time.Millisecond).Format("2006-01-02 15:04:05.000000"),
+		})
+		keys = append(keys, "NavPvt")
+	}
+	jsonPath := writeReplayFile(t, map[string][]proto.Message{"NavPvt": navPvts}, keys)
+	binaryPath := filepath.Join(t.TempDir(), "gnss.replay")
+
+	count, err := ConvertJsonReplayFile(jsonPath, binaryPath)
+	require.NoError(t, err)
+	assert.Equal(t, 40, count)
+
+	var itows []uint32
+	feed := NewGnssReplayFeed(binaryPath, func(redisKey string, data []byte) error {
+		assert.Equal(t, "NavPvt", redisKey)
+		navPvt := &sensordata.NavPvt{}
+		require.NoError(t, proto.Unmarshal(data, navPvt))
+		itows = append(itows, navPvt.ItowMs)
+		return nil
+	}, WithReplaySpeed(0), WithReplayRange(8*time.Second, 9*time.Second))
+
+	require.NoError(t, feed.Run(context.Background()))
+	assert.Equal(t, []uint32{9000, 9250, 9500, 9750, 10000}, itows)
+
+	// without the index the replay decodes from the start
+	require.NoError(t, os.Remove(logger.ReplayIndexPath(binaryPath)))
+	itows = nil
+	require.NoError(t, feed.Run(context.Background()))
+	assert.Equal(t, []uint32{9000, 9250, 9500, 9750, 10000}, itows)
+}
@@ -0,0 +1,114 @@
+package gnss
+
+import (
+	"strings"
+	"time"
+
+	sensordata "github.com/Hivemapper/hivemapper-data-logger/proto-out"
+	"google.golang.org/protobuf/proto"
+)
+
+const msPerWeek = 7 * 24 * 3600 * 1000
+
+// systemTimeLayouts are the layouts of time.Time.String(), used by the redis
+// logger, and of the system time rewritten by the replay
+var systemTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	"2006-01-02 15:04:05.999999999",
+}
+
+// replayClock places recorded messages on a single timeline, in milliseconds
+// since the first message. The gps time of week of the message is used when
+// it has one, the recorded system time otherwise, both anchored on the
+// timeline position when first seen. Messages without any time stay at the
+// position of the previous one. The timeline never goes backwards.
+type replayClock struct {
+	position int64
+
+	haveItow      bool
+	itowBase      int64
+	lastItow      int64
+	weekRollovers int64
+
+	// system time minus timeline position, refreshed by every message
+	// carrying both a time of week and a system time
+	haveSystemTimeOffset bool
+	systemTimeOffset     int64
+}
+
+// place returns the timeline position of msg in milliseconds
+func (c *replayClock) place(msg proto.Message) int64 {
+	itow, hasItow := messageItow(msg)
+	systemTime, hasSystemTime := messageSystemTime(msg)
+	systemTimeMs := systemTime.UnixMilli()
+
+	position := c.position
+	switch {
+	case hasItow:
+		itow = c.unwrapItow(itow)
+		if !c.haveItow {
+			c.haveItow = true
+			c.itowBase = itow - c.position
+		}
+		position = itow - c.itowBase
+		if hasSystemTime {
+			c.systemTimeOffset = systemTimeMs - position
+			c.haveSystemTimeOffset = true
+		}
+	case hasSystemTime:
+		if !c.haveSystemTimeOffset {
+			c.systemTimeOffset = systemTimeMs - c.position
+			c.haveSystemTimeOffset = true
+		}
+		position = systemTimeMs - c.systemTimeOffset
+	}
+
+	if position > c.position {
+		c.position = position
+	}
+	return c.position
+}
+
+// unwrapItow turns the time of week into a continuous time across week
+// rollovers
+func (c *replayClock) unwrapItow(itow int64) int64 {
+	if c.haveItow && itow+c.weekRollovers*msPerWeek < c.lastItow-msPerWeek/2 {
+		c.weekRollovers++
+	}
+	unwrapped := itow + c.weekRollovers*msPerWeek
+	c.lastItow = unwrapped
+	return unwrapped
+}
+
+func messageItow(msg proto.Message) (int64, bool) {
+	switch m := msg.(type) {
+	case *sensordata.TimTp:
+		// tow of the next time pulse, not of the message
+		return 0, false
+	case *sensordata.RxmRawx:
+		return int64(m.RcvTowS * 1000), true
+	case *sensordata.RxmMeasx:
+		return int64(m.GpsTowMs), true
+	case interface{ GetItowMs() uint32 }:
+		return int64(m.GetItowMs()), true
+	}
+	return 0, false
+}
+
+func messageSystemTime(msg proto.Message) (time.Time, bool) {
+	m, ok := msg.(interface{ GetSystemTime() string })
+	if !ok || m.GetSystemTime() == "" {
+		return time.Time{}, false
+	}
+	value := m.GetSystemTime()
+	// time.Time.String() appends the monotonic clock reading
+	if i := strings.Index(value, " m="); i > 0 {
+		value = value[:i]
+	}
+	for _, layout := range systemTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
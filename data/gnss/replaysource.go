@@ -0,0 +1,98 @@
+package gnss
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/Hivemapper/hivemapper-data-logger/logger"
+)
+
+// replaySource reads the redis key and data of the recorded gnss messages,
+// oldest first. next returns io.EOF at the end of the recording.
+type replaySource interface {
+	next() (redisKey string, data []byte, err error)
+	Close() error
+}
+
+func openReplaySource(path string) (replaySource, error) {
+	if logger.IsJsonReplayFile(path) {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("opening gnss replay file: %w", err)
+		}
+		return &jsonReplaySource{file: file, reader: bufio.NewReader(file)}, nil
+	}
+
+	reader, err := logger.OpenReplayFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return &binaryReplaySource{ReplayFileReader: reader}, nil
+}
+
+// jsonReplaySource reads the JSON lines of logger.GnssReplayEvent
+type jsonReplaySource struct {
+	file   *os.File
+	reader *bufio.Reader
+}
+
+func (s *jsonReplaySource) next() (string, []byte, error) {
+	for {
+		line, err := s.reader.ReadString('\n')
+		if err != nil {
+			if err == io.EOF {
+				return "", nil, io.EOF
+			}
+			return "", nil, fmt.Errorf("error reading from gnss replay file: %s", err)
+		}
+
+		var entry logger.GnssReplayEvent
+		err = json.Unmarshal([]byte(line), &entry)
+		if err != nil {
+			fmt.Printf("error unmarshalling gnss json line: %s\n", err)
+			continue
+		}
+		return entry.RedisKey, []byte(entry.Data), nil
+	}
+}
+
+func (s *jsonReplaySource) Close() error {
+	return s.file.Close()
+}
+
+// binaryReplaySource reads the binary container written by logger.Redis
+type binaryReplaySource struct {
+	*logger.ReplayFileReader
+	first time.Time
+}
+
+func (s *binaryReplaySource) next() (string, []byte, error) {
+	record, err := s.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	if s.first.IsZero() {
+		s.first = time.Unix(0, record.SensorTimeNs)
+	}
+	return record.Key, record.Data, nil
+}
+
+// skipTo moves close before the record offset after the first one, using the
+// index of the file. It returns the offset of the record it moved to.
+func (s *binaryReplaySource) skipTo(offset time.Duration) (time.Duration, error) {
+	if _, _, err := s.next(); err != nil {
+		return 0, err
+	}
+	landed, err := s.SeekSensorTime(s.first.Add(offset))
+	if err != nil {
+		return 0, err
+	}
+	if landed.IsZero() {
+		return 0, nil
+	}
+	return landed.Sub(s.first), nil
+}
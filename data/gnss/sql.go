@@ -0,0 +1,109 @@
+package gnss
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Hivemapper/hivemapper-data-logger/data/session"
+	"github.com/daedaleanai/ublox/ubx"
+)
+
+const GnssCreateTable string = `
+  CREATE TABLE IF NOT EXISTS gnss (
+  	id INTEGER NOT NULL PRIMARY KEY,
+  	system_time TIMESTAMP NOT NULL,
+  	itow_ms INTEGER NOT NULL,
+  	gnss_time TIMESTAMP NOT NULL,
+  	valid INTEGER NOT NULL,
+  	fix_type INTEGER NOT NULL,
+  	flags INTEGER NOT NULL,
+  	num_sv INTEGER NOT NULL,
+	latitude REAL NOT NULL,
+	longitude REAL NOT NULL,
+	height REAL NOT NULL,
+	height_msl REAL NOT NULL,
+	h_acc REAL NOT NULL,
+	v_acc REAL NOT NULL,
+	vel_n REAL NOT NULL,
+	vel_e REAL NOT NULL,
+	vel_d REAL NOT NULL,
+	ground_speed REAL NOT NULL,
+	heading REAL NOT NULL,
+	speed_acc REAL NOT NULL,
+	heading_acc REAL NOT NULL,
+	pdop REAL NOT NULL
+  );
+	create index if not exists gnss_time_idx on gnss(system_time);
+`
+
+const GnssAlterTable string = `
+	ALTER TABLE gnss ADD COLUMN session TEXT NOT NULL DEFAULT '';
+`
+
+const insertNavPvtQuery string = `INSERT INTO gnss VALUES `
+const insertNavPvtFields string = `(NULL,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?),`
+
+const purgeQuery string = `
+	DELETE FROM gnss WHERE system_time < ?;
+`
+
+type NavPvtSqlWrapper struct {
+	System_time time.Time
+	NavPvt      *ubx.NavPvt
+}
+
+func NewNavPvtSqlWrapper(system_time time.Time, navPvt *ubx.NavPvt) *NavPvtSqlWrapper {
+	return &NavPvtSqlWrapper{
+		System_time: system_time,
+		NavPvt:      navPvt,
+	}
+}
+
+func (w *NavPvtSqlWrapper) BufferSize() int {
+	return 4
+}
+
+func (w *NavPvtSqlWrapper) InsertQuery() (string, string, []any, error) {
+	sessionID, err := session.GetSession()
+	if err != nil {
+		return "", "", nil, fmt.Errorf("getting session: %w", err)
+	}
+	m := w.NavPvt
+	gnssTime := time.Date(int(m.Year_y), time.Month(m.Month_month), int(m.Day_d), int(m.Hour_h), int(m.Min_min), int(m.Sec_s), int(m.Nano_ns), time.UTC)
+	return insertNavPvtQuery, insertNavPvtFields, []any{
+		w.System_time.Format("2006-01-02 15:04:05.99999"),
+		m.ITOW_ms,
+		gnssTime.Format("2006-01-02 15:04:05.99999"),
+		uint8(m.Valid),
+		uint8(m.FixType),
+		uint8(m.Flags),
+		m.NumSV,
+		float64(m.Lat_dege7) * 1e-7,
+		float64(m.Lon_dege7) * 1e-7,
+		float64(m.Height_mm) / 1000,
+		float64(m.HMSL_mm) / 1000,
+		float64(m.HAcc_mm) / 1000,
+		float64(m.VAcc_mm) / 1000,
+		float64(m.VelN_mm_s) / 1000,
+		float64(m.VelE_mm_s) / 1000,
+		float64(m.VelD_mm_s) / 1000,
+		float64(m.GSpeed_mm_s) / 1000,
+		float64(m.HeadMot_dege5) * 1e-5,
+		float64(m.SAcc_mm_s) / 1000,
+		float64(m.HeadAcc_dege5) * 1e-5,
+		float64(m.PDOP) * 0.01,
+		sessionID,
+	}, nil
+}
+
+func CreateTableQuery() string {
+	return GnssCreateTable
+}
+
+func AlterTableQuery() string {
+	return GnssAlterTable
+}
+
+func PurgeQuery() string {
+	return purgeQuery
+}
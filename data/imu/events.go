@@ -0,0 +1,51 @@
+package imu
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Hivemapper/hivemapper-data-logger/data"
+	"github.com/streamingfast/imu-controller/device/iim42652"
+)
+
+type RawImuEvent struct {
+	*data.BaseEvent
+	Acceleration *Acceleration         `json:"acceleration"`
+	AngularRate  *iim42652.AngularRate `json:"angular_rate"`
+	Temperature  float64               `json:"temperature"`
+}
+
+func NewRawImuEvent(acceleration *Acceleration, angularRate *iim42652.AngularRate, temperature iim42652.Temperature) *RawImuEvent {
+	return &RawImuEvent{
+		BaseEvent:    data.NewBaseEvent("IMU_RAW_EVENT", "IMU", acceleration.Time, nil),
+		Acceleration: acceleration,
+		AngularRate:  angularRate,
+		Temperature:  *temperature,
+	}
+}
+
+func (e *RawImuEvent) String() string {
+	return fmt.Sprintf("RawImuEvent: %s, %s", e.Acceleration, e.AngularRate)
+}
+
+type OrientedAccelerationEvent struct {
+	*data.BaseEvent
+	Acceleration *Acceleration `json:"acceleration"`
+	TiltAngles   *TiltAngles   `json:"tilt_angles"`
+	Temperature  float64       `json:"temperature"`
+	Orientation  Orientation   `json:"orientation"`
+}
+
+func NewOrientedAccelerationEvent(acceleration *Acceleration, tiltAngles *TiltAngles, temperature iim42652.Temperature, orientation Orientation) *OrientedAccelerationEvent {
+	return &OrientedAccelerationEvent{
+		BaseEvent:    data.NewBaseEvent("IMU_ORIENTED_ACCELERATION_EVENT", "IMU", time.Now().UTC(), nil),
+		Acceleration: acceleration,
+		TiltAngles:   tiltAngles,
+		Temperature:  *temperature,
+		Orientation:  orientation,
+	}
+}
+
+func (e *OrientedAccelerationEvent) String() string {
+	return fmt.Sprintf("OrientedAccelerationEvent: %s, orientation %s", e.Acceleration, e.Orientation)
+}
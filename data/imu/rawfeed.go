@@ -1,6 +1,7 @@
 package imu
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"time"
@@ -29,7 +30,8 @@ func NewRawFeed(imu *iim42652.IIM42652, handlers ...RawFeedHandler) *RawFeed {
 
 type RawFeedHandler func(acceleration *Acceleration, angularRate *iim42652.AngularRate, temperature iim42652.Temperature, fsync *iim42652.Fsync) error
 
-func (f *RawFeed) Run(axisMap *iim42652.AxisMap) error {
+// Run reads the imu fifo until ctx is cancelled or reading the device fails.
+func (f *RawFeed) Run(ctx context.Context, axisMap *iim42652.AxisMap) error {
 	fmt.Println("Run imu raw feed")
 
 	// Open log file once before loop
@@ -40,8 +42,16 @@ func (f *RawFeed) Run(axisMap *iim42652.AxisMap) error {
 	defer logFile.Close()
 
 	fifoChan := make(chan ImuRawData, 250) //
+	handlersDone := make(chan struct{})
+	// the handlers are done with the queued data once Run returns, the
+	// caller may close the sinks right after
+	defer func() {
+		close(fifoChan)
+		<-handlersDone
+	}()
 
 	go func() {
+		defer close(handlersDone)
 		for fifodata := range fifoChan {
 			for _, handler := range f.handlers {
 				if err := handler(fifodata.acceleration, fifodata.angularRate, fifodata.temperature, fifodata.fsync); err != nil {
@@ -150,7 +160,11 @@ func (f *RawFeed) Run(axisMap *iim42652.AxisMap) error {
 
 		prev_last_packet_time = time_of_last_packet
 
-		time.Sleep(100 * time.Millisecond)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(100 * time.Millisecond):
+		}
 
 	}
 }
@@ -0,0 +1,93 @@
+package imu
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Hivemapper/hivemapper-data-logger/data/session"
+	"github.com/streamingfast/imu-controller/device/iim42652"
+)
+
+const ImuCreateTable string = `
+  CREATE TABLE IF NOT EXISTS imu (
+  	id INTEGER NOT NULL PRIMARY KEY,
+  	time TIMESTAMP NOT NULL,
+  	system_time TIMESTAMP NOT NULL,
+	acc_x REAL NOT NULL,
+	acc_y REAL NOT NULL,
+	acc_z REAL NOT NULL,
+	gyro_x REAL NOT NULL,
+	gyro_y REAL NOT NULL,
+	gyro_z REAL NOT NULL,
+	temperature REAL NOT NULL,
+	fsync_int INTEGER NOT NULL,
+	fsync_time_delta INTEGER NOT NULL
+  );
+	create index if not exists imu_time_idx on imu(system_time);
+`
+
+const ImuAlterTable string = `
+	ALTER TABLE imu ADD COLUMN session TEXT NOT NULL DEFAULT '';
+`
+
+const insertImuQuery string = `INSERT INTO imu VALUES `
+const insertImuFields string = `(NULL,?,?,?,?,?,?,?,?,?,?,?,?),`
+
+const purgeQuery string = `
+	DELETE FROM imu WHERE system_time < ?;
+`
+
+type ImuSqlWrapper struct {
+	System_time  time.Time
+	Acceleration *Acceleration
+	AngularRate  *iim42652.AngularRate
+	Temperature  float64
+	Fsync        *iim42652.Fsync
+}
+
+func NewImuSqlWrapper(system_time time.Time, temperature iim42652.Temperature, acceleration *Acceleration, angularRate *iim42652.AngularRate, fsync *iim42652.Fsync) *ImuSqlWrapper {
+	return &ImuSqlWrapper{
+		System_time:  system_time,
+		Acceleration: acceleration,
+		AngularRate:  angularRate,
+		Temperature:  *temperature,
+		Fsync:        fsync,
+	}
+}
+
+func (w *ImuSqlWrapper) BufferSize() int {
+	return 100
+}
+
+func (w *ImuSqlWrapper) InsertQuery() (string, string, []any, error) {
+	sessionID, err := session.GetSession()
+	if err != nil {
+		return "", "", nil, fmt.Errorf("getting session: %w", err)
+	}
+	return insertImuQuery, insertImuFields, []any{
+		w.Acceleration.Time.Format("2006-01-02 15:04:05.99999"),
+		w.System_time.Format("2006-01-02 15:04:05.99999"),
+		w.Acceleration.X,
+		w.Acceleration.Y,
+		w.Acceleration.Z,
+		w.AngularRate.X,
+		w.AngularRate.Y,
+		w.AngularRate.Z,
+		w.Temperature,
+		w.Fsync.FsyncInt,
+		w.Fsync.TimeDelta,
+		sessionID,
+	}, nil
+}
+
+func CreateTableQuery() string {
+	return ImuCreateTable
+}
+
+func AlterTableQuery() string {
+	return ImuAlterTable
+}
+
+func PurgeQuery() string {
+	return purgeQuery
+}
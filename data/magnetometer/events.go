@@ -0,0 +1,28 @@
+package magnetometer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Hivemapper/hivemapper-data-logger/data"
+)
+
+type MagnetometerEvent struct {
+	*data.BaseEvent
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+func NewMagnetometerEvent(systemTime time.Time, x float64, y float64, z float64) *MagnetometerEvent {
+	return &MagnetometerEvent{
+		BaseEvent: data.NewBaseEvent("MAGNETOMETER_EVENT", "MAGNETOMETER", systemTime, nil),
+		X:         x,
+		Y:         y,
+		Z:         z,
+	}
+}
+
+func (e *MagnetometerEvent) String() string {
+	return fmt.Sprintf("MagnetometerEvent: x=%f, y=%f, z=%f", e.X, e.Y, e.Z)
+}
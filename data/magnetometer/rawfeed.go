@@ -1,6 +1,7 @@
 package magnetometer
 
 import (
+	"context"
 	"encoding/binary"
 	"fmt"
 	"time"
@@ -62,10 +63,27 @@ func (f *RawFeed) Init() error {
 	return nil
 }
 
-func (f *RawFeed) Run() error {
+func (f *RawFeed) Close() error {
+	if f.device == nil {
+		return nil
+	}
+	err := f.device.Close()
+	f.device = nil
+	if err != nil {
+		return fmt.Errorf("closing I2C device: %w", err)
+	}
+	return nil
+}
+
+// Run reads the magnetometer until ctx is cancelled or reading fails.
+func (f *RawFeed) Run(ctx context.Context) error {
 	fmt.Println("Run mag raw feed")
 	for {
-		time.Sleep(25 * time.Millisecond)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(25 * time.Millisecond):
+		}
 		mag_readings, err := readData(f.device)
 		if err != nil {
 			return fmt.Errorf("getting magnetometer readings: %w", err)
@@ -1,6 +1,7 @@
 package magnetometer
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/Hivemapper/hivemapper-data-logger/data/session"
@@ -25,9 +26,7 @@ const insertMagnetometerQuery string = `INSERT INTO magnetometer VALUES `
 const insertMagnetometerFields string = `(NULL,?,?,?,?,?),`
 
 const purgeQuery string = `
-	DELETE FROM magnetometer WHERE rowid NOT IN (
-		SELECT rowid FROM gnss ORDER BY rowid DESC LIMIT 150000
-	);
+	DELETE FROM magnetometer WHERE system_time < ?;
 `
 
 type MagnetometerSqlWrapper struct {
@@ -47,13 +46,13 @@ func NewMagnetometerSqlWrapper(system_time time.Time, mag_x float64, mag_y float
 }
 
 func (w *MagnetometerSqlWrapper) BufferSize() int {
-	return 10;
+	return 10
 }
 
-func (s *MagnetometerSqlWrapper) InsertQuery() (string, string, []any) {
+func (s *MagnetometerSqlWrapper) InsertQuery() (string, string, []any, error) {
 	sessionID, err := session.GetSession()
 	if err != nil {
-		panic(err) // Handle error if any
+		return "", "", nil, fmt.Errorf("getting session: %w", err)
 	}
 	return insertMagnetometerQuery, insertMagnetometerFields, []any{
 		s.System_time.Format("2006-01-02 15:04:05.99999"),
@@ -61,7 +60,7 @@ func (s *MagnetometerSqlWrapper) InsertQuery() (string, string, []any) {
 		s.Mag_y,
 		s.Mag_z,
 		sessionID,
-	}
+	}, nil
 }
 
 func CreateTableQuery() string {
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/simulator"
+	"github.com/spf13/cobra"
+)
+
+var SimulateCmd = &cobra.Command{
+	Use:   "simulate",
+	Short: "Simulate a NEO-M9N receiver on a pseudo-terminal or a TCP port",
+	RunE:  simulateRun,
+}
+
+func init() {
+	SimulateCmd.Flags().Bool("pty", false, "serve the receiver on a new pseudo-terminal, its path is printed")
+	SimulateCmd.Flags().String("listen-addr", "", "serve the receiver on this TCP address, one connection at a time")
+	SimulateCmd.Flags().String("trajectory", "", "JSON file of the waypoints of the receiver, it stays still otherwise")
+	SimulateCmd.Flags().Int("baud-rate", simulator.DefaultBaudRate, "baud rate of the receiver UART at power on")
+	RootCmd.AddCommand(SimulateCmd)
+}
+
+func simulateRun(cmd *cobra.Command, _ []string) error {
+	options := []simulator.Option{simulator.WithBaudRate(mustGetInt(cmd, "baud-rate"))}
+	if path := mustGetString(cmd, "trajectory"); path != "" {
+		trajectory, err := simulator.LoadTrajectory(path)
+		if err != nil {
+			return err
+		}
+		options = append(options, simulator.WithTrajectory(trajectory))
+	}
+	receiver := simulator.New(options...)
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	if mustGetBool(cmd, "pty") {
+		return simulatePty(ctx, receiver)
+	}
+	if addr := mustGetString(cmd, "listen-addr"); addr != "" {
+		return simulateTCP(ctx, receiver, addr)
+	}
+	return fmt.Errorf("one of --pty or --listen-addr is required")
+}
+
+func simulatePty(ctx context.Context, receiver *simulator.Receiver) error {
+	master, path, err := simulator.OpenPty()
+	if err != nil {
+		return err
+	}
+	defer master.Close()
+	fmt.Println("Simulated receiver on", path)
+
+	go func() {
+		<-ctx.Done()
+		_ = master.Close()
+	}()
+	for ctx.Err() == nil {
+		// reading the master fails while the slave side isn't open, until
+		// the host opens it again
+		err := receiver.Serve(ctx, master)
+		if err != nil && ctx.Err() == nil {
+			time.Sleep(200 * time.Millisecond)
+		}
+	}
+	return nil
+}
+
+func simulateTCP(ctx context.Context, receiver *simulator.Receiver, addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("listening on %s: %w", addr, err)
+	}
+	fmt.Println("Simulated receiver on", listener.Addr())
+
+	go func() {
+		<-ctx.Done()
+		_ = listener.Close()
+	}()
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("accepting connection: %w", err)
+		}
+		fmt.Println("Host connected from", conn.RemoteAddr())
+		if err := receiver.Serve(ctx, conn); err != nil {
+			fmt.Fprintln(os.Stderr, "simulated receiver:", err)
+		}
+		_ = conn.Close()
+		fmt.Println("Host disconnected")
+	}
+}
@@ -0,0 +1,99 @@
+package config
+
+const DefaultProfile = "default"
+
+var builtins = map[string]*Profile{
+	DefaultProfile: {
+		Name: DefaultProfile,
+		Settings: []Setting{
+			// acknowledge assistance input messages
+			{Key: "CFG-NAVSPG-ACKAIDING", Value: true},
+
+			// 4 Hz navigation solutions
+			{Key: "CFG-RATE-MEAS", Value: 250},
+			{Key: "CFG-RATE-NAV", Value: 1},
+
+			// critical navigation messages match the solution rate
+			{Key: "CFG-MSGOUT-UBX_NAV_PVT_UART1", Value: 1},
+			{Key: "CFG-MSGOUT-UBX_SEC_ECSIGN_UART1", Value: 1},
+			{Key: "CFG-MSGOUT-UBX_NAV_COV_UART1", Value: 1},
+			{Key: "CFG-MSGOUT-UBX_NAV_POSECEF_UART1", Value: 1},
+			{Key: "CFG-MSGOUT-UBX_NAV_TIMEGPS_UART1", Value: 1},
+			{Key: "CFG-MSGOUT-UBX_NAV_VELECEF_UART1", Value: 1},
+			{Key: "CFG-MSGOUT-UBX_TIM_TP_UART1", Value: 1},
+			{Key: "CFG-MSGOUT-UBX_NAV_STATUS_UART1", Value: 1},
+			{Key: "CFG-MSGOUT-UBX_NAV_SIG_UART1", Value: 1},
+			// closes every epoch, after its last navigation message
+			{Key: "CFG-MSGOUT-UBX_NAV_EOE_UART1", Value: 1},
+
+			// non critical messages at 1 Hz
+			{Key: "CFG-MSGOUT-UBX_MON_RF_UART1", Value: 4},
+			{Key: "CFG-MSGOUT-UBX_SEC_SIG_UART1", Value: 0},
+
+			// 1 Hz time pulse, 100 ms long
+			{Key: "CFG-TP-TIMEGRID_TP1", Value: 1},
+			{Key: "CFG-TP-PERIOD_TP1", Value: 1000000},
+			{Key: "CFG-TP-PERIOD_LOCK_TP1", Value: 1000000},
+			{Key: "CFG-TP-LEN_TP1", Value: 100000},
+			{Key: "CFG-TP-LEN_LOCK_TP1", Value: 100000},
+
+			// raw measurements
+			{Key: "CFG-MSGOUT-UBX_RXM_MEASX_UART1", Value: 0},
+			{Key: "CFG-MSGOUT-UBX_RXM_RAWX_UART1", Value: 1},
+			{Key: "CFG-MSGOUT-UBX_RXM_SFRBX_UART1", Value: 1},
+
+			// unneeded messages that are on by default
+			{Key: "CFG-MSGOUT-UBX_NAV_SAT_UART1", Value: 0},
+			{Key: "CFG-MSGOUT-NMEA_ID_RMC_I2C", Value: 0},
+			{Key: "CFG-MSGOUT-NMEA_ID_RMC_SPI", Value: 0},
+			{Key: "CFG-MSGOUT-NMEA_ID_VTG_I2C", Value: 0},
+			{Key: "CFG-MSGOUT-NMEA_ID_VTG_SPI", Value: 0},
+			{Key: "CFG-MSGOUT-NMEA_ID_GGA_I2C", Value: 0},
+			{Key: "CFG-MSGOUT-NMEA_ID_GGA_UART1", Value: 0},
+			{Key: "CFG-MSGOUT-NMEA_ID_GGA_SPI", Value: 0},
+			{Key: "CFG-MSGOUT-NMEA_ID_GSA_I2C", Value: 0},
+			{Key: "CFG-MSGOUT-NMEA_ID_GSA_SPI", Value: 0},
+			{Key: "CFG-MSGOUT-NMEA_ID_GSV_I2C", Value: 0},
+			{Key: "CFG-MSGOUT-NMEA_ID_GSV_SPI", Value: 0},
+			{Key: "CFG-MSGOUT-NMEA_ID_GLL_I2C", Value: 0},
+			{Key: "CFG-MSGOUT-NMEA_ID_GLL_SPI", Value: 0},
+			{Key: "CFG-UART1OUTPROT-NMEA", Value: false},
+			{Key: "CFG-I2COUTPROT-UBX", Value: false},
+			{Key: "CFG-I2COUTPROT-NMEA", Value: false},
+			{Key: "CFG-SPIOUTPROT-UBX", Value: false},
+			{Key: "CFG-SPIOUTPROT-NMEA", Value: false},
+			{Key: "CFG-MSGOUT-UBX_MON_SPAN_UART1", Value: 0},
+			{Key: "CFG-MSGOUT-UBX_NAV_TIMELS_UART1", Value: 0},
+			{Key: "CFG-MSGOUT-UBX_MON_SYS_UART1", Value: 0},
+		},
+	},
+
+	// raw-measurements adds what post-processing, e.g. RTKLIB, needs on top
+	// of RAWX and SFRBX
+	"raw-measurements": {
+		Name:    "raw-measurements",
+		Extends: DefaultProfile,
+		Settings: []Setting{
+			{Key: "CFG-MSGOUT-UBX_RXM_MEASX_UART1", Value: 1},
+			{Key: "CFG-MSGOUT-UBX_NAV_SAT_UART1", Value: 1},
+			{Key: "CFG-MSGOUT-UBX_NAV_CLOCK_UART1", Value: 1},
+		},
+	},
+
+	// low-power computes 1 solution per second and only sends the position,
+	// its signature and the time pulse
+	"low-power": {
+		Name:    "low-power",
+		Extends: DefaultProfile,
+		Settings: []Setting{
+			{Key: "CFG-RATE-MEAS", Value: 1000},
+			{Key: "CFG-MSGOUT-UBX_MON_RF_UART1", Value: 1},
+			{Key: "CFG-MSGOUT-UBX_NAV_COV_UART1", Value: 0},
+			{Key: "CFG-MSGOUT-UBX_NAV_POSECEF_UART1", Value: 0},
+			{Key: "CFG-MSGOUT-UBX_NAV_VELECEF_UART1", Value: 0},
+			{Key: "CFG-MSGOUT-UBX_NAV_SIG_UART1", Value: 0},
+			{Key: "CFG-MSGOUT-UBX_RXM_RAWX_UART1", Value: 0},
+			{Key: "CFG-MSGOUT-UBX_RXM_SFRBX_UART1", Value: 0},
+		},
+	},
+}
@@ -0,0 +1,219 @@
+// Package config holds the configuration profiles of the gnss receiver: the
+// configuration keys Neom9n writes with CFG-VALSET when it's initialized.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/daedaleanai/ublox/ubx"
+)
+
+// Layers are the CFG-VALSET layer bits a value is written to
+type Layers byte
+
+const (
+	LayerRam   Layers = 0x01
+	LayerBbr   Layers = 0x02
+	LayerFlash Layers = 0x04
+)
+
+// DefaultLayers is used by the settings without layers: the value is applied
+// at once and kept in flash across power cycles.
+const DefaultLayers = LayerRam | LayerFlash
+
+var layerNames = map[string]Layers{
+	"ram":   LayerRam,
+	"bbr":   LayerBbr,
+	"flash": LayerFlash,
+}
+
+// String returns the names of the layers, e.g. ram+flash
+func (l Layers) String() string {
+	var names []string
+	for _, layer := range []Layers{LayerRam, LayerBbr, LayerFlash} {
+		if l&layer != 0 {
+			for name, value := range layerNames {
+				if value == layer {
+					names = append(names, name)
+				}
+			}
+		}
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, "+")
+}
+
+func (l Layers) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// keyUart1BaudRate is switched by Neom9n itself, it can't be in a profile
+const keyUart1BaudRate = "CFG-UART1-BAUDRATE"
+
+// Setting sets the configuration key Key to Value in Layers. Value is a
+// boolean for the single bit keys, an integer or, for enumerations, the name
+// of a value otherwise. Keys are named as in the u-blox interface description
+// and ubx.CfgKeys.
+type Setting struct {
+	Key    string      `json:"key"`
+	Layers []string    `json:"layers,omitempty"`
+	Value  interface{} `json:"value"`
+}
+
+// Profile is a named set of settings, written in order. A profile extending
+// a built-in one gets the settings of the built-in first, its own settings
+// override the built-in value of a key.
+type Profile struct {
+	Name     string    `json:"name"`
+	Extends  string    `json:"extends,omitempty"`
+	Settings []Setting `json:"settings"`
+}
+
+// Item is a validated setting, ready to be written to the receiver.
+type Item struct {
+	Name   string
+	Key    ubx.CfgKeyID
+	Layers Layers
+	Value  []byte
+}
+
+// Load returns the built-in profile called nameOrPath, or reads and
+// validates the profile file at nameOrPath.
+func Load(nameOrPath string) (*Profile, error) {
+	if profile, ok := Builtin(nameOrPath); ok {
+		return profile, nil
+	}
+
+	data, err := os.ReadFile(nameOrPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading gnss config profile: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	decoder.DisallowUnknownFields()
+	profile := &Profile{}
+	if err := decoder.Decode(profile); err != nil {
+		return nil, fmt.Errorf("parsing gnss config profile %s: %w", nameOrPath, err)
+	}
+	if profile.Name == "" {
+		profile.Name = nameOrPath
+	}
+
+	if _, err := profile.Items(); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// Items resolves the built-in profile p extends and validates every setting.
+func (p *Profile) Items() ([]Item, error) {
+	settings, err := p.resolve(map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []string
+	items := make([]Item, 0, len(settings))
+	for _, setting := range settings {
+		item, err := setting.item()
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		items = append(items, item)
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid gnss config profile %q: %s", p.Name, strings.Join(problems, "; "))
+	}
+	return items, nil
+}
+
+func (p *Profile) resolve(seen map[string]bool) ([]Setting, error) {
+	if p.Extends == "" {
+		return p.Settings, nil
+	}
+	if seen[p.Extends] {
+		return nil, fmt.Errorf("gnss config profile %q extends itself", p.Name)
+	}
+	seen[p.Extends] = true
+
+	base, ok := Builtin(p.Extends)
+	if !ok {
+		return nil, fmt.Errorf("gnss config profile %q extends unknown profile %q, one of: %s", p.Name, p.Extends, strings.Join(BuiltinNames(), ", "))
+	}
+	settings, err := base.resolve(seen)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := append([]Setting(nil), settings...)
+	positions := map[string]int{}
+	for i, setting := range merged {
+		positions[setting.Key] = i
+	}
+	for _, setting := range p.Settings {
+		if i, ok := positions[setting.Key]; ok {
+			merged[i] = setting
+			continue
+		}
+		positions[setting.Key] = len(merged)
+		merged = append(merged, setting)
+	}
+	return merged, nil
+}
+
+func (s Setting) item() (Item, error) {
+	if s.Key == keyUart1BaudRate {
+		return Item{}, fmt.Errorf("%s is set with the gnss baud rate, not in a profile", s.Key)
+	}
+	key, ok := ubx.CfgKeyByName(s.Key)
+	if !ok {
+		return Item{}, fmt.Errorf("unknown key %q", s.Key)
+	}
+
+	layers := DefaultLayers
+	if len(s.Layers) > 0 {
+		layers = 0
+		for _, name := range s.Layers {
+			layer, ok := layerNames[strings.ToLower(name)]
+			if !ok {
+				return Item{}, fmt.Errorf("%s: unknown layer %q, one of ram, bbr or flash", s.Key, name)
+			}
+			layers |= layer
+		}
+	}
+
+	value, err := key.Encode(s.Value)
+	if err != nil {
+		return Item{}, fmt.Errorf("%s: %w", s.Key, err)
+	}
+	return Item{Name: s.Key, Key: key.ID, Layers: layers, Value: value}, nil
+}
+
+// BuiltinNames returns the names of the built-in profiles, sorted
+func BuiltinNames() []string {
+	names := make([]string, 0, len(builtins))
+	for name := range builtins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Builtin returns the built-in profile called name.
+func Builtin(name string) (*Profile, bool) {
+	profile, ok := builtins[name]
+	return profile, ok
+}
+
+// Default returns the profile used when none is configured.
+func Default() *Profile {
+	return builtins[DefaultProfile]
+}
@@ -0,0 +1,120 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Load(t *testing.T) {
+	dir := t.TempDir()
+	writeProfile := func(name string, content string) string {
+		path := filepath.Join(dir, name+".json")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		return path
+	}
+
+	tests := []struct {
+		name          string
+		path          string
+		expectedName  string
+		expectedError string
+	}{
+		{
+			name:         "built-in profile",
+			path:         "low-power",
+			expectedName: "low-power",
+		},
+		{
+			name:          "missing file",
+			path:          filepath.Join(dir, "missing.json"),
+			expectedError: "reading gnss config profile",
+		},
+		{
+			name:         "profile file",
+			path:         writeProfile("bench", `{"name": "bench", "extends": "raw-measurements", "settings": [{"key": "CFG-RATE-MEAS", "layers": ["ram"], "value": 100}]}`),
+			expectedName: "bench",
+		},
+		{
+			name:          "unknown key",
+			path:          writeProfile("unknown-key", `{"name": "bench", "settings": [{"key": "CFG-RATE-MEASURE", "value": 100}]}`),
+			expectedError: `unknown key "CFG-RATE-MEASURE"`,
+		},
+		{
+			name:          "value too large",
+			path:          writeProfile("too-large", `{"name": "bench", "settings": [{"key": "CFG-MSGOUT-UBX_NAV_PVT_UART1", "value": 256}]}`),
+			expectedError: "CFG-MSGOUT-UBX_NAV_PVT_UART1: 256 doesn't fit in U1",
+		},
+		{
+			name:         "enumeration by name",
+			path:         writeProfile("enum", `{"name": "car", "settings": [{"key": "CFG-NAVSPG-DYNMODEL", "value": "AUTOMOT"}]}`),
+			expectedName: "car",
+		},
+		{
+			name:          "unknown enumeration value",
+			path:          writeProfile("unknown-enum", `{"name": "car", "settings": [{"key": "CFG-NAVSPG-DYNMODEL", "value": "CAR"}]}`),
+			expectedError: `CFG-NAVSPG-DYNMODEL: unknown value "CAR"`,
+		},
+		{
+			name:          "boolean key",
+			path:          writeProfile("boolean", `{"name": "bench", "settings": [{"key": "CFG-NAVSPG-ACKAIDING", "value": 2}]}`),
+			expectedError: "CFG-NAVSPG-ACKAIDING: expected a boolean, got 2",
+		},
+		{
+			name:          "unknown layer",
+			path:          writeProfile("layer", `{"name": "bench", "settings": [{"key": "CFG-RATE-MEAS", "layers": ["rom"], "value": 100}]}`),
+			expectedError: `CFG-RATE-MEAS: unknown layer "rom"`,
+		},
+		{
+			name:          "baud rate",
+			path:          writeProfile("baud-rate", `{"name": "bench", "settings": [{"key": "CFG-UART1-BAUDRATE", "value": 115200}]}`),
+			expectedError: "CFG-UART1-BAUDRATE is set with the gnss baud rate",
+		},
+		{
+			name:          "unknown base profile",
+			path:          writeProfile("unknown-base", `{"name": "bench", "extends": "high-power"}`),
+			expectedError: `extends unknown profile "high-power"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			profile, err := Load(test.path)
+			if test.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedName, profile.Name)
+			_, err = profile.Items()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_ProfileItems(t *testing.T) {
+	profile := &Profile{
+		Name:    "bench",
+		Extends: "raw-measurements",
+		Settings: []Setting{
+			{Key: "CFG-RATE-MEAS", Layers: []string{"ram"}, Value: 100},
+			{Key: "CFG-MSGOUT-UBX_NAV_DOP_UART1", Value: 1},
+		},
+	}
+	items, err := profile.Items()
+	require.NoError(t, err)
+
+	defaults, err := Default().Items()
+	require.NoError(t, err)
+	require.Len(t, items, len(defaults)+2, "overridden keys aren't repeated")
+
+	assert.Equal(t, defaults[0], items[0], "the settings of the built-in profile come first")
+	assert.Equal(t, Item{Name: "CFG-RATE-MEAS", Key: 0x30210001, Layers: LayerRam, Value: []byte{100, 0}}, items[1], "overridden in place")
+	assert.Equal(t, Item{Name: "CFG-TP-PERIOD_TP1", Key: 0x40050002, Layers: DefaultLayers, Value: []byte{0x40, 0x42, 0x0f, 0x00}}, items[16])
+	assert.Equal(t, Item{Name: "CFG-NAVSPG-ACKAIDING", Key: 0x10110025, Layers: DefaultLayers, Value: []byte{0x01}}, items[0])
+	assert.Equal(t, Item{Name: "CFG-MSGOUT-UBX_NAV_DOP_UART1", Key: 0x20910039, Layers: DefaultLayers, Value: []byte{0x01}}, items[len(items)-1])
+}
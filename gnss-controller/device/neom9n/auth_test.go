@@ -0,0 +1,48 @@
+package neom9n
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"testing"
+
+	"github.com/Hivemapper/gnss-controller/message"
+	"github.com/Hivemapper/gnss-controller/simulator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Authentication(t *testing.T) {
+	signingKey, err := ecdsa.GenerateKey(message.P192(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(message.P192(), rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		signingKey *ecdsa.PrivateKey
+		publicKey  *ecdsa.PublicKey
+		expected   message.AuthStatus
+	}{
+		{"signed with the receiver key", signingKey, &signingKey.PublicKey, message.AuthVerified},
+		{"signed with another key", signingKey, &otherKey.PublicKey, message.AuthFailed},
+		{"not signed", nil, &signingKey.PublicKey, message.AuthFailed},
+		{"no public key", signingKey, nil, message.AuthUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receiver := simulator.New(simulator.WithSigningKey(tt.signingKey))
+			var options []Option
+			if tt.publicKey != nil {
+				options = append(options, WithPublicKey(tt.publicKey))
+			}
+			device := simulatedDevice(t, receiver, options...)
+
+			data := &dataCollector{}
+			runDevice(device, data, &navPvtCollector{})
+
+			for _, d := range data.waitForSigned(t, 2) {
+				assert.Equal(t, tt.expected, d.AuthStatus)
+			}
+		})
+	}
+}
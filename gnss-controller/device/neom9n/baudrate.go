@@ -0,0 +1,133 @@
+package neom9n
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/config"
+	"github.com/Hivemapper/gnss-controller/message"
+	"github.com/Hivemapper/gnss-controller/transport"
+	"github.com/daedaleanai/ublox/ubx"
+)
+
+// targetBaudRate is the baud rate Init switches the receiver UART to
+const targetBaudRate = 921600
+
+const (
+	probeTimeout = 300 * time.Millisecond
+	probeRetries = 1
+
+	// corruptionLimit is the number of corrupted frames in a row after which
+	// the baud rate is probed again
+	corruptionLimit = 20
+)
+
+// standardBaudRates are probed after the current and the target baud rates,
+// the most likely ones first
+var standardBaudRates = []int{38400, 9600, 115200, 230400, 460800, 57600, 19200, 4800}
+
+// probeBaudRates returns the baud rates to probe, current first
+func probeBaudRates(current int) []int {
+	seen := map[int]bool{}
+	var baudRates []int
+	for _, baudRate := range append([]int{current, targetBaudRate}, standardBaudRates...) {
+		if baudRate <= 0 || seen[baudRate] {
+			continue
+		}
+		seen[baudRate] = true
+		baudRates = append(baudRates, baudRate)
+	}
+	return baudRates
+}
+
+// connect finds the baud rate the receiver talks at and switches it to
+// targetBaudRate
+func (n *Neom9n) connect(ctx context.Context, setter transport.BaudRateSetter) error {
+	baudRate, err := n.probeBaudRate(ctx, setter)
+	if err != nil {
+		return err
+	}
+	if baudRate == targetBaudRate {
+		fmt.Println("gnss receiver already at", targetBaudRate, "bauds")
+		return nil
+	}
+
+	err = n.setConfig(ctx, config.DefaultLayers, ubx.CfgKeyUart1Baudrate, uint32(targetBaudRate), "CFG-UART1-BAUDRATE")
+	if err != nil {
+		fmt.Println(time.Now().UTC(), "Set config failed:", err)
+	}
+	n.stop()
+	setter.SetBaudRate(targetBaudRate)
+	if err := n.open(); err != nil {
+		return err
+	}
+	if n.probe(ctx) {
+		fmt.Println("===== NEW: Baud changed =====")
+		return nil
+	}
+
+	// the receiver didn't switch, talk at whatever rate it answers
+	baudRate, err = n.probeBaudRate(ctx, setter)
+	if err != nil {
+		return err
+	}
+	fmt.Println("gnss receiver stayed at", baudRate, "bauds")
+	return nil
+}
+
+// probeBaudRate opens the transport at each of the probed baud rates until
+// the receiver answers, and returns the baud rate it answered at. The
+// transport is left open at that rate.
+func (n *Neom9n) probeBaudRate(ctx context.Context, setter transport.BaudRateSetter) (int, error) {
+	baudRates := probeBaudRates(setter.BaudRate())
+	for _, baudRate := range baudRates {
+		n.stop()
+		setter.SetBaudRate(baudRate)
+		if err := n.open(); err != nil {
+			return 0, err
+		}
+		fmt.Println(time.Now().UTC(), "Probing gnss receiver at", baudRate, "bauds")
+		if n.probe(ctx) {
+			return baudRate, nil
+		}
+		if n.isClosed() {
+			return 0, errClosed
+		}
+	}
+	return 0, fmt.Errorf("gnss receiver not answering at any of %v bauds", baudRates)
+}
+
+// probe tells if the receiver answers a poll of its baud rate at the baud
+// rate of the stream, a NAK is an answer too
+func (n *Neom9n) probe(ctx context.Context) bool {
+	err := n.send(ctx, &ubx.CfgValGetReq{
+		Version: 0x00,
+		Layer:   ubx.CfgValGetLayerRam,
+		Keys:    []ubx.CfgKeyID{ubx.CfgKeyUart1Baudrate},
+	}, probeTimeout, probeRetries)
+	return err == nil || errors.Is(err, message.ErrNak)
+}
+
+// reconnect probes the baud rate again once the decoder gave up on a
+// corrupted stream, and writes the profile again in case the receiver was
+// reset.
+func (n *Neom9n) reconnect(ctx context.Context) error {
+	setter, ok := n.transport.(transport.BaudRateSetter)
+	if !ok {
+		return fmt.Errorf("reconnecting over %s: no baud rate to probe", n.transport)
+	}
+	if err := n.connect(ctx, setter); err != nil {
+		return fmt.Errorf("reconnecting to gps: %w", err)
+	}
+
+	items, err := n.profile.Items()
+	if err != nil {
+		return err
+	}
+	result := n.writeProfile(ctx, items)
+	n.setConfigResult(result)
+	fmt.Println(result)
+	return nil
+}
@@ -0,0 +1,62 @@
+package neom9n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/simulator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BaudRateProbe(t *testing.T) {
+	tests := []struct {
+		name             string
+		receiverBaudRate int
+	}{
+		{"at the initial baud rate", simulator.DefaultBaudRate},
+		{"left at 921600 by a previous run", 921600},
+		{"at another standard baud rate", 115200},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receiver := simulator.New(simulator.WithBaudRate(tt.receiverBaudRate))
+			hostTransport := simulator.NewTransport(receiver, simulator.DefaultBaudRate)
+			device := NewNeom9n("", "", simulator.DefaultBaudRate, false, WithTransport(hostTransport))
+			require.NoError(t, device.Init(nil))
+			defer device.Close()
+
+			assert.Equal(t, 921600, receiver.BaudRate())
+			assert.Equal(t, 921600, hostTransport.BaudRate())
+			assert.Empty(t, device.ConfigResult().Mismatches())
+		})
+	}
+}
+
+func Test_BaudRateReprobe(t *testing.T) {
+	receiver := simulator.New()
+	device := simulatedDevice(t, receiver)
+
+	collector := &navPvtCollector{}
+	received := func() int {
+		collector.lock.Lock()
+		defer collector.lock.Unlock()
+		return len(collector.itows)
+	}
+
+	done := runDevice(device, &dataCollector{}, collector)
+	require.Eventually(t, func() bool { return received() > 0 }, 5*time.Second, 20*time.Millisecond)
+
+	// the receiver is reset to 115200 behind the back of the host, which
+	// reads corrupted frames until it probes the baud rate again
+	receiver.SetBaudRate(115200)
+	require.Eventually(t, func() bool { return receiver.BaudRate() == 921600 }, 10*time.Second, 20*time.Millisecond)
+	before := received()
+	require.Eventually(t, func() bool { return received() > before }, 5*time.Second, 20*time.Millisecond, "the solutions come through again")
+
+	select {
+	case err := <-done:
+		t.Fatalf("the device stopped: %v", err)
+	default:
+	}
+}
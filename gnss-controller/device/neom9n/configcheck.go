@@ -0,0 +1,221 @@
+package neom9n
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/config"
+	"github.com/Hivemapper/gnss-controller/message"
+	"github.com/daedaleanai/ublox/ubx"
+)
+
+// CFG-VALGET takes up to 64 keys per poll
+const maxValGetKeys = 64
+
+// valGetLayers maps the CFG-VALSET layer bits to the layer CFG-VALGET reads
+var valGetLayers = []struct {
+	bits  config.Layers
+	layer ubx.CfgValGetLayer
+}{
+	{config.LayerRam, ubx.CfgValGetLayerRam},
+	{config.LayerBbr, ubx.CfgValGetLayerBBR},
+	{config.LayerFlash, ubx.CfgValGetLayerFlash},
+}
+
+// ConfigCheck is the value of a configuration item in one layer, read back
+// after the profile is written.
+type ConfigCheck struct {
+	Name     string
+	Key      ubx.CfgKeyID
+	Layer    config.Layers
+	Expected []byte
+	Actual   []byte // nil when the receiver doesn't report the key
+	Written  bool   // false when the layer already held the expected value
+}
+
+func (c ConfigCheck) Matches() bool {
+	return c.Actual != nil && bytes.Equal(c.Expected, c.Actual)
+}
+
+func (c ConfigCheck) format(value []byte) string {
+	if value == nil {
+		return "missing"
+	}
+	if key, ok := ubx.CfgKeyByID(c.Key); ok {
+		return key.Format(value)
+	}
+	return fmt.Sprintf("% x", value)
+}
+
+func (c ConfigCheck) String() string {
+	return fmt.Sprintf("%s in %s: expected %s, got %s", c.Name, c.Layer, c.format(c.Expected), c.format(c.Actual))
+}
+
+func (c ConfigCheck) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name     string        `json:"name"`
+		Layer    config.Layers `json:"layer"`
+		Expected string        `json:"expected"`
+		Actual   string        `json:"actual"`
+		Written  bool          `json:"written"`
+		Matches  bool          `json:"matches"`
+	}{c.Name, c.Layer, c.format(c.Expected), c.format(c.Actual), c.Written, c.Matches()})
+}
+
+// ConfigResult is the outcome of writing the configuration profile in Init,
+// one check per item and layer.
+type ConfigResult struct {
+	Profile string        `json:"profile"`
+	Checks  []ConfigCheck `json:"checks"`
+}
+
+// Written returns the number of values written
+func (r *ConfigResult) Written() int {
+	count := 0
+	for _, check := range r.Checks {
+		if check.Written {
+			count++
+		}
+	}
+	return count
+}
+
+// Mismatches returns the checks whose value isn't the expected one
+func (r *ConfigResult) Mismatches() []ConfigCheck {
+	var mismatches []ConfigCheck
+	for _, check := range r.Checks {
+		if !check.Matches() {
+			mismatches = append(mismatches, check)
+		}
+	}
+	return mismatches
+}
+
+func (r *ConfigResult) String() string {
+	mismatches := r.Mismatches()
+	s := fmt.Sprintf("gnss config profile %s: %d values written, %d already set, %d mismatches", r.Profile, r.Written(), len(r.Checks)-r.Written(), len(mismatches))
+	if len(mismatches) == 0 {
+		return s
+	}
+	lines := []string{s}
+	for _, mismatch := range mismatches {
+		lines = append(lines, "  "+mismatch.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// writeProfile writes the items which don't have their value yet in every
+// layer, then reads them back.
+func (n *Neom9n) writeProfile(ctx context.Context, items []config.Item) *ConfigResult {
+	before := n.readLayers(ctx, items, nil)
+
+	written := map[ubx.CfgKeyID]config.Layers{}
+	for _, item := range items {
+		var layers config.Layers
+		for _, l := range valGetLayers {
+			if item.Layers&l.bits != 0 && !bytes.Equal(before[l.bits][item.Key], item.Value) {
+				layers |= l.bits
+			}
+		}
+		if layers == 0 {
+			continue
+		}
+		if err := n.setConfig(ctx, layers, item.Key, item.Value, item.Name); err != nil {
+			fmt.Println(time.Now().UTC(), "Set config failed:", err)
+		}
+		written[item.Key] = layers
+	}
+
+	after := n.readLayers(ctx, items, written)
+	result := &ConfigResult{Profile: n.profile.Name}
+	for _, item := range items {
+		for _, l := range valGetLayers {
+			if item.Layers&l.bits == 0 {
+				continue
+			}
+			actual, ok := after[l.bits][item.Key]
+			if !ok && written[item.Key]&l.bits == 0 {
+				actual = before[l.bits][item.Key]
+			}
+			result.Checks = append(result.Checks, ConfigCheck{
+				Name:     item.Name,
+				Key:      item.Key,
+				Layer:    l.bits,
+				Expected: item.Value,
+				Actual:   actual,
+				Written:  written[item.Key]&l.bits != 0,
+			})
+		}
+	}
+	return result
+}
+
+// readLayers polls the values of items in each of their layers, only the
+// written ones when written isn't nil.
+func (n *Neom9n) readLayers(ctx context.Context, items []config.Item, written map[ubx.CfgKeyID]config.Layers) map[config.Layers]map[ubx.CfgKeyID][]byte {
+	values := map[config.Layers]map[ubx.CfgKeyID][]byte{}
+	for _, l := range valGetLayers {
+		var keys []ubx.CfgKeyID
+		for _, item := range items {
+			if item.Layers&l.bits == 0 {
+				continue
+			}
+			if written != nil && written[item.Key]&l.bits == 0 {
+				continue
+			}
+			keys = append(keys, item.Key)
+		}
+		values[l.bits] = map[ubx.CfgKeyID][]byte{}
+		for start := 0; start < len(keys); start += maxValGetKeys {
+			end := start + maxValGetKeys
+			if end > len(keys) {
+				end = len(keys)
+			}
+			for key, value := range n.getConfig(ctx, l.layer, keys[start:end]) {
+				values[l.bits][key] = value
+			}
+		}
+	}
+	return values
+}
+
+// getConfig polls keys in layer, the keys the receiver doesn't report are
+// missing from the returned values.
+func (n *Neom9n) getConfig(ctx context.Context, layer ubx.CfgValGetLayer, keys []ubx.CfgKeyID) map[ubx.CfgKeyID][]byte {
+	decoder, _ := n.connection()
+	replies := decoder.ConfigReplies()
+	for len(replies) > 0 {
+		<-replies
+	}
+
+	values := map[ubx.CfgKeyID][]byte{}
+	err := n.Send(ctx, &ubx.CfgValGetReq{
+		Version: 0x00,
+		Layer:   layer,
+		Keys:    keys,
+	})
+	if err != nil {
+		// the receiver NAKs a poll when none of the keys are in the layer
+		if !errors.Is(err, message.ErrNak) {
+			fmt.Println(time.Now().UTC(), "Get config failed, layer:", layer, err)
+		}
+		return values
+	}
+
+	// the reply comes before the ACK of the poll
+	select {
+	case reply := <-replies:
+		for _, data := range reply.CfgData {
+			if value, ok := data.Value.([]byte); ok {
+				values[data.Key] = value
+			}
+		}
+	default:
+	}
+	return values
+}
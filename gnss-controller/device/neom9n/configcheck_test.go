@@ -0,0 +1,53 @@
+package neom9n
+
+import (
+	"testing"
+
+	"github.com/Hivemapper/gnss-controller/config"
+	"github.com/Hivemapper/gnss-controller/simulator"
+	"github.com/daedaleanai/ublox/ubx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ConfigReadBack(t *testing.T) {
+	receiver := simulator.New(simulator.WithRejectedKeys(ubx.CfgKeyMsgoutUbxNavSigUart1))
+	items, err := config.Default().Items()
+	require.NoError(t, err)
+
+	initDevice := func(baudRate int) *ConfigResult {
+		device := NewNeom9n("", "", baudRate, false,
+			WithTransport(simulator.NewTransport(receiver, baudRate)))
+		require.NoError(t, device.Init(nil))
+		require.NoError(t, device.Close())
+		return device.ConfigResult()
+	}
+
+	result := initDevice(simulator.DefaultBaudRate)
+	require.NotNil(t, result)
+	assert.Equal(t, config.DefaultProfile, result.Profile)
+	assert.Len(t, result.Checks, 2*len(items), "every item is checked in ram and flash")
+	assert.Equal(t, 2*len(items)-1, result.Written(), "CFG-RATE-NAV is already 1 in ram")
+
+	mismatches := result.Mismatches()
+	require.Len(t, mismatches, 2)
+	assert.Equal(t, ConfigCheck{
+		Name:     "CFG-MSGOUT-UBX_NAV_SIG_UART1",
+		Key:      ubx.CfgKeyMsgoutUbxNavSigUart1,
+		Layer:    config.LayerFlash,
+		Expected: []byte{0x01},
+		Written:  true,
+	}, mismatches[1], "the rejected key isn't in flash")
+	assert.Equal(t, "CFG-MSGOUT-UBX_NAV_SIG_UART1 in flash: expected 1, got missing", mismatches[1].String())
+
+	flash, ok := receiver.Value(simulator.LayerFlash, ubx.CfgKeyRateMeas)
+	require.True(t, ok)
+	assert.Equal(t, []byte{250, 0}, flash, "CFG-RATE-MEAS is in flash")
+
+	// the receiver keeps its configuration, only the rejected key is written
+	// again
+	result = initDevice(921600)
+	require.NotNil(t, result)
+	assert.Equal(t, 2, result.Written())
+	assert.Len(t, result.Mismatches(), 2)
+}
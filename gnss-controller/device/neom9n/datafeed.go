@@ -11,51 +11,171 @@ type Logger interface {
 	Log(data *Data) error
 }
 
+// Position is in degrees, Altitude in meters above the ellipsoid
 type Position struct {
 	Latitude  float64 `json:"latitude"`
 	Longitude float64 `json:"longitude"`
 	Altitude  float64 `json:"height"`
 }
 
+// Velocity is in meters per second
+type Velocity struct {
+	North float64 `json:"north"`
+	East  float64 `json:"east"`
+	Down  float64 `json:"down"`
+}
+
+// Satellites are the satellites with a signal tracked, Seen, and the ones
+// used in the solution
+type Satellites struct {
+	Seen int `json:"seen"`
+	Used int `json:"used"`
+}
+
+// Data is the navigation solution of an epoch, and of the SEC-ECSIGN signing
+// it when there is one. The solution fields of a message missing from the
+// epoch are zero, but for the DOPs which fall back on the PDOP of NAV-PVT.
 type Data struct {
 	SystemTime      time.Time      `json:"systemtime"`
 	SecEcsign       *ubx.SecEcsign `json:"sec_ecsign"`
 	SecEcsignBuffer string         `json:"sec_ecsign_buffer"`
-	//todo: add optional signature and hash struct genereated from UBX-SEC-ECSIGN messages by the decoder
+	// AuthStatus tells if the hash and signature of SecEcsign match the
+	// frames of SecEcsignBuffer, unknown without SecEcsign
+	AuthStatus message.AuthStatus `json:"auth_status"`
+	// SecEcsignPartial tells SecEcsignBuffer may miss frames SecEcsign
+	// covers, a hash mismatch is then unknown rather than failed
+	SecEcsignPartial bool `json:"sec_ecsign_partial"`
+
+	ITOW uint32 `json:"itow_ms"`
+	// Timestamp is the UTC time of the solution, zero until the receiver
+	// knows the date and time
+	Timestamp    time.Time  `json:"timestamp"`
+	TimeResolved bool       `json:"time_resolved"`
+	Fix          string     `json:"fix"`
+	GnssFixOk    bool       `json:"gnss_fix_ok"`
+	Position     Position   `json:"position"`
+	Velocity     Velocity   `json:"velocity"`
+	Heading      float64    `json:"heading"` // degrees, heading of motion
+	Speed        float64    `json:"speed"`   // meters per second, over ground
+	Dop          Dop        `json:"dop"`
+	Satellites   Satellites `json:"satellites"`
+
+	// accuracy estimates, in meters, meters per second and degrees
+	HorizontalAccuracy float64 `json:"horizontal_accuracy"`
+	VerticalAccuracy   float64 `json:"vertical_accuracy"`
+	SpeedAccuracy      float64 `json:"speed_accuracy"`
+	HeadingAccuracy    float64 `json:"heading_accuracy"`
 }
 
+// Dop are the dilutions of precision, only PDop is set without NAV-DOP
 type Dop struct {
 	GDop float64 `json:"gdop"`
 	HDop float64 `json:"hdop"`
 	PDop float64 `json:"pdop"`
 	TDop float64 `json:"tdop"`
 	VDop float64 `json:"vdop"`
-	XDop float64 `json:"xdop"`
-	YDop float64 `json:"ydop"`
+	XDop float64 `json:"xdop"` // easting
+	YDop float64 `json:"ydop"` // northing
 }
 
+// DataFeed collects the navigation messages of every epoch into Data and
+// hands it over when the epoch is closed. The receiver sends a SEC-ECSIGN
+// after the epoch it signs, the data of that epoch is handed over again with
+// the signature as soon as it arrives.
 type DataFeed struct {
 	HandleData func(data *Data)
-	Data       *Data
+	// Data is the latest epoch handed over, without signature
+	Data *Data
+
+	epochs *EpochAssembler
 }
 
 func NewDataFeed(handleData func(data *Data)) *DataFeed {
-	return &DataFeed{
+	df := &DataFeed{
 		HandleData: handleData,
 		Data:       &Data{},
 	}
+	df.epochs = NewEpochAssembler(df.handleEpoch)
+	return df
 }
 
 func (df *DataFeed) HandleUbxMessage(msg interface{}) error {
-	data := df.Data
-
 	switch m := msg.(type) {
 	case *message.SecEcsignWithBuffer:
-		data.SystemTime = time.Now().UTC()
-		data.SecEcsign = m.SecEcsign
-		data.SecEcsignBuffer = m.Base64MessageBuffer
-		df.HandleData(data)
+		signed := *df.Data
+		if signed.SystemTime.IsZero() {
+			// no epoch was closed since the stream was opened
+			signed.SystemTime = time.Now().UTC()
+		}
+		signed.SecEcsign = m.SecEcsign
+		signed.SecEcsignBuffer = m.Base64MessageBuffer
+		signed.AuthStatus = m.AuthStatus
+		signed.SecEcsignPartial = m.Partial
+		df.HandleData(&signed)
+	default:
+		return df.epochs.HandleUbxMessage(msg)
+	}
+
+	return nil
+}
+
+func (df *DataFeed) handleEpoch(epoch *Epoch) error {
+	data := &Data{
+		SystemTime: epoch.SystemTime,
+		ITOW:       epoch.ITOW,
+	}
+
+	if m := epoch.NavPvt; m != nil {
+		if m.Valid&ubx.NavPvtValidDate != 0 && m.Valid&ubx.NavPvtValidTime != 0 {
+			data.Timestamp = time.Date(int(m.Year_y), time.Month(m.Month_month), int(m.Day_d),
+				int(m.Hour_h), int(m.Min_min), int(m.Sec_s), int(m.Nano_ns), time.UTC)
+		}
+		data.TimeResolved = m.Valid&ubx.NavPvtFullyResolved != 0
+		data.Fix = ubx.NavPvtFixType(m.FixType).String()
+		data.GnssFixOk = m.Flags&ubx.NavPvtGnssFixOK != 0
+		data.Position = Position{
+			Latitude:  float64(m.Lat_dege7) * 1e-7,
+			Longitude: float64(m.Lon_dege7) * 1e-7,
+			Altitude:  float64(m.Height_mm) / 1e3,
+		}
+		data.Velocity = Velocity{
+			North: float64(m.VelN_mm_s) / 1e3,
+			East:  float64(m.VelE_mm_s) / 1e3,
+			Down:  float64(m.VelD_mm_s) / 1e3,
+		}
+		data.Heading = float64(m.HeadMot_dege5) * 1e-5
+		data.Speed = float64(m.GSpeed_mm_s) / 1e3
+		data.Satellites.Used = int(m.NumSV)
+		data.HorizontalAccuracy = float64(m.HAcc_mm) / 1e3
+		data.VerticalAccuracy = float64(m.VAcc_mm) / 1e3
+		data.SpeedAccuracy = float64(m.SAcc_mm_s) / 1e3
+		data.HeadingAccuracy = float64(m.HeadAcc_dege5) * 1e-5
+		if epoch.NavDop == nil {
+			data.Dop = Dop{PDop: float64(m.PDOP) * 0.01}
+		}
+	}
+
+	if m := epoch.NavDop; m != nil {
+		data.Dop = Dop{
+			GDop: float64(m.GDOP) * 0.01,
+			HDop: float64(m.HDOP) * 0.01,
+			PDop: float64(m.PDOP) * 0.01,
+			TDop: float64(m.TDOP) * 0.01,
+			VDop: float64(m.VDOP) * 0.01,
+			XDop: float64(m.EDOP) * 0.01,
+			YDop: float64(m.NDOP) * 0.01,
+		}
+	}
+
+	if m := epoch.NavSig; m != nil {
+		seen := map[[2]byte]bool{}
+		for _, sig := range m.Sigs {
+			seen[[2]byte{sig.GnssId, sig.SvId}] = true
+		}
+		data.Satellites.Seen = len(seen)
 	}
 
+	df.Data = data
+	df.HandleData(data)
 	return nil
 }
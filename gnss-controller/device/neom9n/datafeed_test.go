@@ -0,0 +1,106 @@
+package neom9n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/message"
+	"github.com/daedaleanai/ublox/ubx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DataFeed(t *testing.T) {
+	var handled []Data
+	feed := NewDataFeed(func(data *Data) {
+		handled = append(handled, *data)
+	})
+
+	messages := []interface{}{
+		&ubx.NavPvt{
+			ITOW_ms: 1000, Year_y: 2024, Month_month: 1, Day_d: 1, Hour_h: 10, Sec_s: 2, Nano_ns: 500000000,
+			Valid:   ubx.NavPvtValidDate | ubx.NavPvtValidTime,
+			FixType: byte(ubx.NavPvtFix2D), NumSV: 4, Height_mm: 1500, VelE_mm_s: -2000, GSpeed_mm_s: 2000,
+			HeadMot_dege5: 27000000, HAcc_mm: 5000, PDOP: 350,
+		},
+		&ubx.NavDop{ITOW_ms: 1000, PDOP: 250, HDOP: 150, NDOP: 100, EDOP: 110},
+		&ubx.NavSig{ITOW_ms: 1000, Sigs: []*ubx.NavSigSigsType{{SvId: 2}, {SvId: 2, SigId: 3}, {GnssId: 2, SvId: 2}}},
+		&ubx.NavEoe{ITOW_ms: 1000},
+		&message.SecEcsignWithBuffer{SecEcsign: &ubx.SecEcsign{MsgNum: 4}},
+		// NAV-DOP is sent every other epoch
+		&ubx.NavPvt{ITOW_ms: 1250, FixType: byte(ubx.NavPvtFix3D), Flags: ubx.NavPvtGnssFixOK, NumSV: 5, PDOP: 200},
+		&ubx.NavEoe{ITOW_ms: 1250},
+		&message.SecEcsignWithBuffer{SecEcsign: &ubx.SecEcsign{MsgNum: 2}, AuthStatus: message.AuthVerified},
+		// NAV-PVT lost
+		&ubx.NavSig{ITOW_ms: 1500, Sigs: []*ubx.NavSigSigsType{{SvId: 7}}},
+		&ubx.NavEoe{ITOW_ms: 1500},
+		// the end of the stream
+		&message.SecEcsignWithBuffer{SecEcsign: &ubx.SecEcsign{MsgNum: 3}, AuthStatus: message.AuthFailed},
+	}
+	for _, msg := range messages {
+		require.NoError(t, feed.HandleUbxMessage(msg))
+	}
+
+	require.Len(t, handled, 6, "every epoch is handed over, then again with its SEC-ECSIGN")
+	first := handled[0]
+	assert.Equal(t, uint32(1000), first.ITOW)
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 0, 2, 500000000, time.UTC), first.Timestamp)
+	assert.Equal(t, "Fix2D", first.Fix)
+	assert.False(t, first.GnssFixOk)
+	assert.Equal(t, 1.5, first.Position.Altitude)
+	assert.Equal(t, Velocity{East: -2}, first.Velocity)
+	assert.Equal(t, 270.0, first.Heading)
+	assert.Equal(t, 2.0, first.Speed)
+	assert.Equal(t, 5.0, first.HorizontalAccuracy)
+	assert.Equal(t, Dop{PDop: 2.5, HDop: 1.5, XDop: 1.1, YDop: 1}, first.Dop, "NAV-DOP over the PDOP of NAV-PVT")
+	assert.Equal(t, Satellites{Seen: 2, Used: 4}, first.Satellites, "satellites with several signals are seen once")
+	assert.Nil(t, first.SecEcsign)
+	assert.Equal(t, message.AuthUnknown, first.AuthStatus)
+
+	firstSigned := handled[1]
+	require.NotNil(t, firstSigned.SecEcsign)
+	assert.Equal(t, uint16(4), firstSigned.SecEcsign.MsgNum)
+	firstSigned.SecEcsign = nil
+	assert.Equal(t, first, firstSigned, "the signature is attached to the epoch it signs")
+	assert.Nil(t, feed.Data.SecEcsign, "the latest epoch isn't amended")
+
+	second := handled[2]
+	assert.Equal(t, uint32(1250), second.ITOW)
+	assert.True(t, second.Timestamp.IsZero(), "the date and time aren't valid")
+	assert.Equal(t, "Fix3D", second.Fix)
+	assert.True(t, second.GnssFixOk)
+	assert.Equal(t, Dop{PDop: 2}, second.Dop)
+	assert.Equal(t, Satellites{Used: 5}, second.Satellites, "seen is cleared without NAV-SIG")
+	assert.Nil(t, second.SecEcsign)
+
+	secondSigned := handled[3]
+	assert.Equal(t, uint32(1250), secondSigned.ITOW)
+	require.NotNil(t, secondSigned.SecEcsign)
+	assert.Equal(t, uint16(2), secondSigned.SecEcsign.MsgNum)
+	assert.Equal(t, message.AuthVerified, secondSigned.AuthStatus)
+
+	third := handled[4]
+	assert.Equal(t, uint32(1500), third.ITOW)
+	assert.Empty(t, third.Fix, "the solution is cleared without NAV-PVT")
+	assert.Equal(t, Position{}, third.Position)
+	assert.Equal(t, Dop{}, third.Dop)
+	assert.Equal(t, Satellites{Seen: 1}, third.Satellites)
+
+	last := handled[5]
+	assert.Equal(t, uint32(1500), last.ITOW)
+	require.NotNil(t, last.SecEcsign, "the last message of the stream is handed over")
+	assert.Equal(t, uint16(3), last.SecEcsign.MsgNum)
+	assert.Equal(t, message.AuthFailed, last.AuthStatus)
+}
+
+func Test_DataFeedSignatureFirst(t *testing.T) {
+	var handled []Data
+	feed := NewDataFeed(func(data *Data) {
+		handled = append(handled, *data)
+	})
+
+	require.NoError(t, feed.HandleUbxMessage(&message.SecEcsignWithBuffer{SecEcsign: &ubx.SecEcsign{MsgNum: 1}}))
+	require.Len(t, handled, 1, "handed over without a navigation message")
+	assert.False(t, handled[0].SystemTime.IsZero())
+	assert.Equal(t, uint16(1), handled[0].SecEcsign.MsgNum)
+}
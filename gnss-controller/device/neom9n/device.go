@@ -1,86 +1,250 @@
 package neom9n
 
 import (
+	"context"
+	"crypto/ecdsa"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"sync"
 	"time"
 
+	"github.com/Hivemapper/gnss-controller/config"
 	"github.com/Hivemapper/gnss-controller/message"
 	"github.com/Hivemapper/gnss-controller/message/handlers"
+	"github.com/Hivemapper/gnss-controller/transport"
 	"github.com/daedaleanai/ublox/ubx"
-	"github.com/tarm/serial"
 )
 
 type Neom9n struct {
 	startTime          time.Time
-	config             *serial.Config
+	transport          transport.Transport
 	handlersRegistry   *message.HandlerRegistry
-	decoder            *message.Decoder
-	stream             *serial.Port
 	output             chan ubx.Message
 	mgaOfflineFilePath string
 	decoderDone        chan error
+	closed             chan struct{}
 	measxEnabled       bool
-	ackWaitCounter     int
+	sendTimeout        time.Duration
+	sendRetries        int
+
+	// the stream and its decoder are replaced when the baud rate is probed,
+	// the config result when the profile is written again
+	lock    sync.Mutex
+	decoder *message.Decoder
+	stream  io.ReadWriteCloser
+
+	profile      *config.Profile
+	configResult *ConfigResult
+	rawCapture   *message.RawCapture
+	publicKey    *ecdsa.PublicKey
 }
 
-func NewNeom9n(serialConfigName string, mgaOfflineFilePath string, initialBaudRate int, measxEnabled bool) *Neom9n {
+type Option func(*Neom9n)
+
+// WithRawCapture writes every frame received from the receiver to capture
+func WithRawCapture(capture *message.RawCapture) Option {
+	return func(n *Neom9n) {
+		n.rawCapture = capture
+	}
+}
+
+// WithPublicKey verifies the SEC-ECSIGN signatures with the public key of the
+// receiver, see message.ParsePublicKey
+func WithPublicKey(publicKey *ecdsa.PublicKey) Option {
+	return func(n *Neom9n) {
+		n.publicKey = publicKey
+	}
+}
+
+// WithConfigProfile writes profile to the receiver instead of the default
+// profile
+func WithConfigProfile(profile *config.Profile) Option {
+	return func(n *Neom9n) {
+		n.profile = profile
+	}
+}
+
+// WithTransport talks to the receiver over t instead of the serial port
+func WithTransport(t transport.Transport) Option {
+	return func(n *Neom9n) {
+		n.transport = t
+	}
+}
+
+// WithUbxFile reads the frames from a .ubx file instead of the serial port,
+// speed times faster than they were captured or as fast as possible for a
+// speed of 0. The receiver isn't configured and Run returns at the end of
+// the file.
+func WithUbxFile(path string, speed float64) Option {
+	return WithTransport(transport.NewFile(path, speed))
+}
+
+func NewNeom9n(serialConfigName string, mgaOfflineFilePath string, initialBaudRate int, measxEnabled bool, opts ...Option) *Neom9n {
 	n := &Neom9n{
-		startTime: time.Now().UTC(),
-		config: &serial.Config{
-			Name: serialConfigName, // /dev/ttyAMA1
-			//Baud: 921600,
-			Baud:     initialBaudRate,
-			Parity:   serial.ParityNone,
-			StopBits: serial.Stop1,
-		},
+		startTime:          time.Now().UTC(),
+		transport:          transport.NewSerial(serialConfigName, initialBaudRate), // /dev/ttyAMA1
 		handlersRegistry:   message.NewHandlerRegistry(),
 		mgaOfflineFilePath: mgaOfflineFilePath,
 		output:             make(chan ubx.Message),
+		closed:             make(chan struct{}),
 		measxEnabled:       measxEnabled,
+		profile:            config.Default(),
+		sendTimeout:        defaultSendTimeout,
+		sendRetries:        defaultSendRetries,
+	}
+
+	for _, opt := range opts {
+		opt(n)
 	}
 
 	return n
 }
 
-func (n *Neom9n) handleOutputMessages() error {
+func (n *Neom9n) handleOutputMessages() {
 	for {
-		msg := <-n.output
-		if _, ok := msg.(*ubx.MonRf); ok {
-			encoded, err := ubx.EncodeReq(msg)
-			_, err = n.stream.Write(encoded)
-			if err != nil {
-
-				return fmt.Errorf("writing message: %w", err)
-			}
+		var msg ubx.Message
+		select {
+		case <-n.closed:
+			return
+		case msg = <-n.output:
+		}
+		decoder, stream := n.connection()
+		if err := writeMessage(stream, msg); err != nil {
+			// stops Run with the error instead of killing the process
+			decoder.Shutdown(err)
 		}
+	}
+}
 
-		encoded, err := ubx.Encode(msg)
-		_, err = n.stream.Write(encoded)
+func writeMessage(stream io.Writer, msg ubx.Message) error {
+	if _, ok := msg.(*ubx.MonRf); ok {
+		encoded, err := ubx.EncodeReq(msg)
+		_, err = stream.Write(encoded)
 		if err != nil {
+
 			return fmt.Errorf("writing message: %w", err)
 		}
 	}
+
+	encoded, err := ubx.Encode(msg)
+	_, err = stream.Write(encoded)
+	if err != nil {
+		return fmt.Errorf("writing message: %w", err)
+	}
+	return nil
 }
 
-func (n *Neom9n) Init(lastPosition *Position) error {
-	n.decoder = message.NewDecoder(n.handlersRegistry)
-	stream, err := serial.OpenPort(n.config)
+// onceClosedStream lets both the decoder, when shut down, and Close close the
+// stream.
+type onceClosedStream struct {
+	io.ReadWriteCloser
+	once     sync.Once
+	closeErr error
+}
 
-	if err != nil {
-		return fmt.Errorf("opening gps serial port: %w", err)
+func newOnceClosedStream(stream io.ReadWriteCloser) *onceClosedStream {
+	return &onceClosedStream{ReadWriteCloser: stream}
+}
+
+func (s *onceClosedStream) Close() error {
+	s.once.Do(func() {
+		s.closeErr = s.ReadWriteCloser.Close()
+	})
+	return s.closeErr
+}
+
+func (n *Neom9n) newDecoder() *message.Decoder {
+	var options []message.DecoderOption
+	if n.rawCapture != nil {
+		options = append(options, message.WithRawCapture(n.rawCapture))
 	}
+	if n.publicKey != nil {
+		options = append(options, message.WithPublicKey(n.publicKey))
+	}
+	if _, ok := n.transport.(transport.BaudRateSetter); ok {
+		// Run probes the baud rate again when the stream gets corrupted
+		options = append(options, message.WithCorruptionLimit(corruptionLimit))
+	}
+	return message.NewDecoder(n.handlersRegistry, options...)
+}
 
+// connection returns the current decoder and its stream
+func (n *Neom9n) connection() (*message.Decoder, io.ReadWriteCloser) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.decoder, n.stream
+}
+
+func (n *Neom9n) setConnection(decoder *message.Decoder, stream io.ReadWriteCloser) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if n.isClosed() {
+		_ = stream.Close()
+		return errClosed
+	}
+	n.decoder = decoder
 	n.stream = stream
-	go func() {
-		err = n.handleOutputMessages()
+	return nil
+}
+
+// open opens the transport and decodes the stream with a new decoder
+func (n *Neom9n) open() error {
+	stream, err := n.transport.Open()
+	if err != nil {
+		return err
+	}
+	decoder := n.newDecoder()
+	onceClosed := newOnceClosedStream(stream)
+	if err := n.setConnection(decoder, onceClosed); err != nil {
+		return err
+	}
+	n.decoderDone = decoder.Decode(onceClosed)
+	return nil
+}
+
+// stop shuts the decoder down, which closes its stream, and waits for it
+func (n *Neom9n) stop() {
+	decoder, _ := n.connection()
+	if decoder == nil || n.decoderDone == nil {
+		return
+	}
+	decoder.Shutdown(nil)
+	<-n.decoderDone
+	n.decoderDone = nil
+}
+
+func (n *Neom9n) isClosed() bool {
+	select {
+	case <-n.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (n *Neom9n) Init(lastPosition *Position) error {
+	items, err := n.profile.Items()
+	if err != nil {
+		return err
+	}
+
+	// commands are bounded by their acknowledgement timeout and by Close
+	ctx := context.Background()
+
+	fmt.Println("Connecting to gps over", n.transport)
+	go n.handleOutputMessages()
+
+	if _, ok := n.transport.(*transport.File); ok {
+		// a capture can't be configured, decoding starts in Run once the
+		// handlers are registered
+		stream, err := n.transport.Open()
 		if err != nil {
-			panic(err)
+			return err
 		}
-	}()
-
-	_ = n.decoder.Decode(n.stream, n.config)
+		return n.setConnection(n.newDecoder(), newOnceClosedStream(stream))
+	}
 
 	// n.delConfig(1079115777, "CFG-UART1-BAUDRATE")
 	// n.delConfig(807469057, "CFG-RATE-MEAS")
@@ -88,76 +252,18 @@ func (n *Neom9n) Init(lastPosition *Position) error {
 	// n.delConfig(546374490, "CFG-MSGOUT-UBX_MON_RF_UART1")
 	// n.delConfig(546373639, "CFG-MSGOUT-UBX_NAV_PVT_UART1")
 
-	n.setConfig(1079115777, uint32(921600), "CFG-UART1-BAUDRATE") // CFG-UART1-BAUDRATE 0x40520001 The baud rate that should be configured on the UART1
-
-	n.decoder.Shutdown(nil)
-
-	n.config.Baud = 921600
-	n.stream.Close()
-	n.stream, err = serial.OpenPort(n.config)
-	n.decoder = message.NewDecoder(n.handlersRegistry)
-	n.decoderDone = n.decoder.Decode(n.stream, n.config)
-
-	fmt.Println("===== NEW: Baud changed =====")
-
-	n.setConfig(0x10110025, []byte{0x01}, "CFG-NAVSPG-ACKAIDING") // CFG-NAVSPG-ACKAIDING 0x10110025 Acknowledge assistance input messages
-
-	// set nominal rate of measurements -> navigation solution update rate
-	measurement_frequency := 4
-	n.setConfig(0x30210001, uint16(1000/measurement_frequency), "CFG-RATE-MEAS 0x30210001") // CFG-RATE-MEAS 0x30210001 U2 0.001 s Nominal time between GNSS measurements
-	n.setConfig(0x30210002, uint16(1), "CFG-RATE-NAV")                                      // CFG-RATE-NAV 0x30210002 Ratio of number of measurements to number of navigation solutions
-
-	// set critical navigation messages to match solution epoch rate
-	n.setConfig(0x20910007, []byte{0x01}, "CFG-MSGOUT-UBX_NAV_PVT_UART1") // CFG-MSGOUT-UBX_NAV_PVT_UART1 0x20910007 Output rate of the UBX-NAV-PVT message on port UART1
-	n.setConfig(0x2091034b, []byte{0x01}, "CFG-MSGOUT-UBX_SEC_ECSIGN_UART1")
-	n.setConfig(0x20910084, []byte{0x01}, "CFG-MSGOUT-UBX_NAV_COV_UART1")
-	n.setConfig(0x20910025, []byte{0x01}, "CFG-MSGOUT-UBX_NAV_POSECEF_UART1")
-	n.setConfig(0x20910048, []byte{0x01}, "CFG-MSGOUT-UBX_NAV_TIMEGPS_UART1")
-	n.setConfig(0x2091003e, []byte{0x01}, "CFG-MSGOUT-UBX_NAV_VELECEF_UART1")
-	n.setConfig(0x2091017e, []byte{0x01}, "CFG-MSGOUT-UBX_TIM_TP_UART1")
-	n.setConfig(0x2091001b, []byte{0x01}, "CFG-MSGOUT-UBX_NAV_STATUS_UART1")
-	n.setConfig(0x20910346, []byte{0x01}, "CFG-MSGOUT-UBX_NAV_SIG_UART1")
-
-	// non critical messages set to 1 Hz
-	n.setConfig(0x2091035a, uint8(measurement_frequency), "CFG-MSGOUT-UBX_MON_RF_UART1") // CFG-MSGOUT-UBX_MON_RF_UART1 0x2091035a Output rate of the UBX-MON-RF message on port UART1
-	n.setConfig(0x20910635, uint8(0), "CFG-MSGOUT-UBX_SEC_SIG_UART1")
-
-	// set timepulse configurations
-	imu_frequency := 1
-	n.setConfig(0x2005000c, []byte{0x01}, "CFG-TP-TIMEGRID_TP1")
-	n.setConfig(0x40050002, uint32(1000000/imu_frequency), "CFG-TP-PERIOD_TP1")
-	n.setConfig(0x40050003, uint32(1000000/imu_frequency), "CFG-TP-PERIOD_LOCK_TP1")
-	n.setConfig(0x40050004, uint32(100000/imu_frequency), "CFG-TP-LEN_TP1")
-	n.setConfig(0x40050005, uint32(100000/imu_frequency), "CFG-TP-LEN_LOCK_TP1")
-
-	// add raw measurements
-	n.setConfig(0x20910205, []byte{0x00}, "CFG-MSGOUT-UBX_RXM_MEASX_UART1")
-	n.setConfig(0x209102a5, []byte{0x01}, "CFG-MSGOUT-UBX_RXM_RAWX_UART1")
-	n.setConfig(0x20910232, []byte{0x01}, "CFG-MSGOUT-UBX_RXM_SFRBX_UART1")
-
-	// turn off unneeded messages that are default on
-	n.setConfig(0x20910016, []byte{0x00}, "CFG-MSGOUT-UBX_NAV_SAT_UART1")
-	n.setConfig(0x209100ab, []byte{0x00}, "CFG-MSGOUT-NMEA_ID_RMC_I2C")
-	n.setConfig(0x209100af, []byte{0x00}, "CFG-MSGOUT-NMEA_ID_RMC_SPI")
-	n.setConfig(0x209100b0, []byte{0x00}, "CFG-MSGOUT-NMEA_ID_VTG_I2C")
-	n.setConfig(0x209100b4, []byte{0x00}, "CFG-MSGOUT-NMEA_ID_VTG_SPI")
-	n.setConfig(0x209100ba, []byte{0x00}, "CFG-MSGOUT-NMEA_ID_GGA_I2C")
-	n.setConfig(0x209100bb, []byte{0x00}, "CFG-MSGOUT-NMEA_ID_GGA_UART1")
-	n.setConfig(0x209100be, []byte{0x00}, "CFG-MSGOUT-NMEA_ID_GGA_SPI")
-	n.setConfig(0x209100bf, []byte{0x00}, "CFG-MSGOUT-NMEA_ID_GSA_I2C")
-	n.setConfig(0x209100c3, []byte{0x00}, "CFG-MSGOUT-NMEA_ID_GSA_SPI")
-	n.setConfig(0x209100c4, []byte{0x00}, "CFG-MSGOUT-NMEA_ID_GSV_I2C")
-	n.setConfig(0x209100c8, []byte{0x00}, "CFG-MSGOUT-NMEA_ID_GSV_SPI")
-	n.setConfig(0x209100c9, []byte{0x00}, "CFG-MSGOUT-NMEA_ID_GLL_I2C")
-	n.setConfig(0x209100cd, []byte{0x00}, "CFG-MSGOUT-NMEA_ID_GLL_SPI")
-	n.setConfig(0x10740002, []byte{0x00}, "CFG-UART1OUTPROT-NMEA")
-	n.setConfig(0x10720001, []byte{0x00}, "CFG-I2COUTPROT-UBX")
-	n.setConfig(0x10720002, []byte{0x00}, "CFG-I2COUTPROT-NMEA")
-	n.setConfig(0x107a0001, []byte{0x00}, "CFG-SPIOUTPROT-UBX")
-	n.setConfig(0x107a0002, []byte{0x00}, "CFG-SPIOUTPROT-NMEA")
-	n.setConfig(0x2091038c, []byte{0x00}, "CFG-MSGOUT-UBX_MON_SPAN_UART1")
-	n.setConfig(0x20910061, []byte{0x00}, "CFG-MSGOUT-UBX_NAV_TIMELS_UART1")
-	n.setConfig(0x2091069e, []byte{0x00}, "CFG-MSGOUT-UBX_MON_SYS_UART1")
+	if baudRateSetter, ok := n.transport.(transport.BaudRateSetter); ok {
+		if err := n.connect(ctx, baudRateSetter); err != nil {
+			return err
+		}
+	} else if err := n.open(); err != nil {
+		return err
+	}
+
+	fmt.Println("Writing gnss config profile", n.profile.Name)
+	result := n.writeProfile(ctx, items)
+	n.setConfigResult(result)
+	fmt.Println(result)
 
 	if lastPosition != nil {
 		fmt.Println("last position:", lastPosition)
@@ -172,57 +278,76 @@ func (n *Neom9n) Init(lastPosition *Position) error {
 	return nil
 }
 
-func (n *Neom9n) setConfig(key uint32, value interface{}, description string) {
-	n.output <- &ubx.CfgValSet{
+// ConfigResult returns the values of the configuration profile read back from
+// the receiver by Init, nil when it wasn't configured.
+func (n *Neom9n) ConfigResult() *ConfigResult {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.configResult
+}
+
+func (n *Neom9n) setConfigResult(result *ConfigResult) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.configResult = result
+}
+
+// Close stops the decoder and closes the stream to the receiver. Run returns once the
+// decoder is done.
+func (n *Neom9n) Close() error {
+	n.lock.Lock()
+	if n.isClosed() {
+		n.lock.Unlock()
+		return nil
+	}
+	close(n.closed)
+	decoder, stream := n.decoder, n.stream
+	n.lock.Unlock()
+
+	if decoder != nil {
+		decoder.Shutdown(nil)
+	}
+	if stream != nil {
+		if err := stream.Close(); err != nil {
+			return fmt.Errorf("closing gps stream: %w", err)
+		}
+	}
+	return nil
+}
+
+// setConfig writes value to key in layers and waits for the receiver to
+// acknowledge it
+func (n *Neom9n) setConfig(ctx context.Context, layers config.Layers, key ubx.CfgKeyID, value interface{}, description string) error {
+	fmt.Println(time.Now().UTC(), "Set config:", description, "value:", value)
+	return n.Send(ctx, &ubx.CfgValSet{
 		Version: 0x00,
-		Layers:  ubx.CfgValSetLayers(ubx.CfgValSetLayersRam | ubx.CfgValSetLayersFlash | ubx.CfgValSetLayersBBR),
+		Layers:  ubx.CfgValSetLayers(layers),
 		CfgData: []*ubx.CfgData{
 			{
 				Key:   key,
 				Value: value,
 			},
 		},
-	}
-	fmt.Println(time.Now().UTC(), "Set config:", description, "value:", value)
-	// wait for ACK message from decoder before sending next message
-	n.ackWaitCounter = 0
-	for n.ackWaitCounter < 25 {
-		if n.decoder.MessageAcknowledged {
-			n.decoder.MessageAcknowledged = false
-			break
-		}
-		time.Sleep(20 * time.Millisecond)
-		n.ackWaitCounter++
-	}
-	if n.ackWaitCounter == 25 {
-		fmt.Println(time.Now().UTC(), "Set config not acknowledged")
-	}
+	})
 }
 
-// func (n *Neom9n) getConfig(key uint32) {
-// 	n.output <- &ubx.CfgValGetReq{
-// 		Version: 0x00,
-// 		Layers:  ubx.CfgValSetLayers(ubx.CfgValSetLayersRam),
-// 		Key:     key,
-// 	}
-// 	time.Sleep(100 * time.Millisecond)
-// }
-
-// func (n *Neom9n) delConfig(key uint32, description string) {
+// func (n *Neom9n) delConfig(key ubx.CfgKeyID, description string) {
 // 	n.output <- &ubx.CfgValDel{
-// 		Layers: ubx.CfgValSetLayers(ubx.CfgValSetLayersRam | ubx.CfgValSetLayersFlash | ubx.CfgValSetLayersBBR),
-// 		Key:    key,
+// 		Layers: ubx.CfgValSetLayersFlash | ubx.CfgValSetLayersBBR,
+// 		Keys:   []ubx.CfgKeyID{key},
 // 	}
 
 // 	fmt.Println("Deleted config:", description)
 // 	time.Sleep(100 * time.Millisecond)
 // }
 
-func (n *Neom9n) Run(dataFeed *DataFeed, redisFeed message.UbxMessageHandler, redisLogsEnabled bool) error {
+func (n *Neom9n) Run(dataFeed *DataFeed, ubxHandler message.UbxMessageHandler, ubxHandlerEnabled bool) error {
 	now := time.Time{}
 	loadAll := true
 
-	if _, err := os.Stat(n.mgaOfflineFilePath); errors.Is(err, os.ErrNotExist) {
+	if _, ok := n.transport.(*transport.File); ok {
+		// the assistance data can't be sent to a capture
+	} else if _, err := os.Stat(n.mgaOfflineFilePath); errors.Is(err, os.ErrNotExist) {
 		fmt.Printf("File %s does not exist\n", n.mgaOfflineFilePath)
 	} else {
 		go func() {
@@ -240,30 +365,48 @@ func (n *Neom9n) Run(dataFeed *DataFeed, redisFeed message.UbxMessageHandler, re
 	// We need to pass a buffer along with ubx.SecEcsign to the data handler,
 	// so we must register a composite class instead of ubx.SecEcsign
 	n.handlersRegistry.RegisterHandler(message.UbxSecEcsignWithBuffer, dataFeed)
-
-	if redisLogsEnabled {
-		fmt.Println("Registering redis handlers")
-		n.handlersRegistry.RegisterHandler(message.UbxMsgNavPvt, redisFeed)
-		n.handlersRegistry.RegisterHandler(message.UbxMsgNavCov, redisFeed)
-		n.handlersRegistry.RegisterHandler(message.UbxMsgNavPosecef, redisFeed)
-		n.handlersRegistry.RegisterHandler(message.UbxMsgNavTimegps, redisFeed)
-		n.handlersRegistry.RegisterHandler(message.UbxMsgNavVelecef, redisFeed)
-		n.handlersRegistry.RegisterHandler(message.UbxMsgNavStatus, redisFeed)
-		n.handlersRegistry.RegisterHandler(message.UbxMsgNavDop, redisFeed)
-		// n.handlersRegistry.RegisterHandler(message.UbxMsgNavSat, redisFeed)
-		n.handlersRegistry.RegisterHandler(message.UbxMsgNavSig, redisFeed)
-		n.handlersRegistry.RegisterHandler(message.UbxMsgMonRf, redisFeed)
-		n.handlersRegistry.RegisterHandler(message.UbxRxmMeasx, redisFeed)
-		n.handlersRegistry.RegisterHandler(message.UbxRxmRawx, redisFeed)
-		n.handlersRegistry.RegisterHandler(message.UbxRxmSfrbx, redisFeed)
-		n.handlersRegistry.RegisterHandler(message.UbxTimTp, redisFeed)
+	// the navigation solution of the data
+	n.handlersRegistry.RegisterHandler(message.UbxMsgNavPvt, dataFeed)
+	n.handlersRegistry.RegisterHandler(message.UbxMsgNavDop, dataFeed)
+	n.handlersRegistry.RegisterHandler(message.UbxMsgNavSig, dataFeed)
+	n.handlersRegistry.RegisterHandler(message.UbxMsgNavEoe, dataFeed)
+
+	if ubxHandlerEnabled {
+		fmt.Println("Registering ubx handlers")
+		n.handlersRegistry.RegisterHandler(message.UbxMsgNavPvt, ubxHandler)
+		n.handlersRegistry.RegisterHandler(message.UbxMsgNavCov, ubxHandler)
+		n.handlersRegistry.RegisterHandler(message.UbxMsgNavPosecef, ubxHandler)
+		n.handlersRegistry.RegisterHandler(message.UbxMsgNavTimegps, ubxHandler)
+		n.handlersRegistry.RegisterHandler(message.UbxMsgNavVelecef, ubxHandler)
+		n.handlersRegistry.RegisterHandler(message.UbxMsgNavStatus, ubxHandler)
+		n.handlersRegistry.RegisterHandler(message.UbxMsgNavDop, ubxHandler)
+		// n.handlersRegistry.RegisterHandler(message.UbxMsgNavSat, ubxHandler)
+		n.handlersRegistry.RegisterHandler(message.UbxMsgNavSig, ubxHandler)
+		n.handlersRegistry.RegisterHandler(message.UbxMsgNavEoe, ubxHandler)
+		n.handlersRegistry.RegisterHandler(message.UbxMsgMonRf, ubxHandler)
+		n.handlersRegistry.RegisterHandler(message.UbxRxmMeasx, ubxHandler)
+		n.handlersRegistry.RegisterHandler(message.UbxRxmRawx, ubxHandler)
+		n.handlersRegistry.RegisterHandler(message.UbxRxmSfrbx, ubxHandler)
+		n.handlersRegistry.RegisterHandler(message.UbxTimTp, ubxHandler)
 	} else {
-		fmt.Println("Redis handler not set")
+		fmt.Println("Ubx handler not set")
 	}
 
-	if err := <-n.decoderDone; err != nil {
-		return err
+	if n.decoderDone == nil {
+		decoder, stream := n.connection()
+		n.decoderDone = decoder.Decode(stream)
 	}
 
-	return nil
+	for {
+		err := <-n.decoderDone
+		n.decoderDone = nil
+		if !errors.Is(err, message.ErrCorruptedStream) || n.isClosed() {
+			return err
+		}
+		// the receiver was reset or its baud rate changed behind our back
+		fmt.Println(time.Now().UTC(), "Probing the gnss baud rate again:", err)
+		if err := n.reconnect(context.Background()); err != nil {
+			return err
+		}
+	}
 }
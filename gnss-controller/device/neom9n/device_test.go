@@ -0,0 +1,60 @@
+package neom9n
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/message"
+	"github.com/daedaleanai/ublox/ubx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type navPvtCollector struct {
+	lock  sync.Mutex
+	itows []uint32
+	last  *ubx.NavPvt
+}
+
+func (c *navPvtCollector) HandleUbxMessage(msg interface{}) error {
+	if navPvt, ok := msg.(*ubx.NavPvt); ok {
+		c.lock.Lock()
+		c.itows = append(c.itows, navPvt.ITOW_ms)
+		c.last = navPvt
+		c.lock.Unlock()
+	}
+	return nil
+}
+
+func Test_UbxFile(t *testing.T) {
+	dir := t.TempDir()
+	capture := message.NewRawCapture(dir, 1024*1024, 2)
+	receiveTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 4; i++ {
+		frame, err := ubx.Encode(&ubx.NavPvt{ITOW_ms: uint32(1000 + i*250)})
+		require.NoError(t, err)
+		require.NoError(t, capture.Write(receiveTime.Add(time.Duration(i)*250*time.Millisecond), frame))
+	}
+	require.NoError(t, capture.Write(receiveTime.Add(time.Second), []byte("$GNGGA,,,,,,0,00,99.99,,,,,,*56")))
+	require.NoError(t, capture.Close())
+
+	files, err := filepath.Glob(filepath.Join(dir, "*"+message.RawCaptureExtension))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	content, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	assert.Equal(t, "$GNGGA,,,,,,0,00,99.99,,,,,,*56\r\n", string(content[len(content)-33:]), "nmea frames are written as received")
+
+	device := NewNeom9n("", "", 0, false, WithUbxFile(files[0], 4))
+	require.NoError(t, device.Init(nil))
+	defer device.Close()
+
+	collector := &navPvtCollector{}
+	start := time.Now()
+	require.NoError(t, device.Run(NewDataFeed(func(*Data) {}), collector, true), "the run ends with the file")
+	assert.GreaterOrEqual(t, time.Since(start), 180*time.Millisecond, "frames are paced on their receive times")
+	assert.Equal(t, []uint32{1000, 1250, 1500, 1750}, collector.itows)
+}
@@ -0,0 +1,226 @@
+package neom9n
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/config"
+	"github.com/daedaleanai/ublox/ubx"
+)
+
+// EpochFlags are the messages received for an epoch, one bit per message
+type EpochFlags uint32
+
+const (
+	EpochNavPvt EpochFlags = 1 << iota
+	EpochNavCov
+	EpochNavDop
+	EpochNavPosecef
+	EpochNavTimegps
+	EpochNavVelecef
+	EpochNavStatus
+	EpochNavSig
+	EpochNavEoe
+)
+
+var epochFlagNames = []struct {
+	flag EpochFlags
+	name string
+	key  ubx.CfgKeyID
+}{
+	{EpochNavPvt, "NAV-PVT", ubx.CfgKeyMsgoutUbxNavPvtUart1},
+	{EpochNavCov, "NAV-COV", ubx.CfgKeyMsgoutUbxNavCovUart1},
+	{EpochNavDop, "NAV-DOP", ubx.CfgKeyMsgoutUbxNavDopUart1},
+	{EpochNavPosecef, "NAV-POSECEF", ubx.CfgKeyMsgoutUbxNavPosecefUart1},
+	{EpochNavTimegps, "NAV-TIMEGPS", ubx.CfgKeyMsgoutUbxNavTimegpsUart1},
+	{EpochNavVelecef, "NAV-VELECEF", ubx.CfgKeyMsgoutUbxNavVelecefUart1},
+	{EpochNavStatus, "NAV-STATUS", ubx.CfgKeyMsgoutUbxNavStatusUart1},
+	{EpochNavSig, "NAV-SIG", ubx.CfgKeyMsgoutUbxNavSigUart1},
+	{EpochNavEoe, "NAV-EOE", ubx.CfgKeyMsgoutUbxNavEoeUart1},
+}
+
+// String returns the names of the messages, e.g. NAV-PVT|NAV-EOE
+func (f EpochFlags) String() string {
+	var names []string
+	for _, flag := range epochFlagNames {
+		if f&flag.flag != 0 {
+			names = append(names, flag.name)
+		}
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, "|")
+}
+
+// DefaultEpochMessages are the messages of an epoch with the default
+// configuration profile
+const DefaultEpochMessages = EpochNavPvt | EpochNavCov | EpochNavPosecef | EpochNavTimegps |
+	EpochNavVelecef | EpochNavStatus | EpochNavSig | EpochNavEoe
+
+// ExpectedEpochMessages returns the messages the receiver sends for every
+// epoch with the configuration items: the ones with an output rate of 1.
+// Messages sent every n epochs can't be expected in a given epoch.
+func ExpectedEpochMessages(items []config.Item) EpochFlags {
+	var expected EpochFlags
+	for _, item := range items {
+		for _, flag := range epochFlagNames {
+			if item.Key != flag.key {
+				continue
+			}
+			if len(item.Value) == 1 && item.Value[0] == 1 {
+				expected |= flag.flag
+			} else {
+				expected &^= flag.flag
+			}
+		}
+	}
+	return expected
+}
+
+// Epoch is every navigation message of one solution of the receiver, the
+// messages sharing the same iTOW. SystemTime is the time the first message
+// of the epoch was received. A message that didn't arrive is nil, Flags tells
+// which ones did.
+type Epoch struct {
+	ITOW       uint32
+	SystemTime time.Time
+	NavPvt     *ubx.NavPvt
+	NavCov     *ubx.NavCov
+	NavDop     *ubx.NavDop
+	NavPosecef *ubx.NavPosecef
+	NavTimegps *ubx.NavTimegps
+	NavVelecef *ubx.NavVelecef
+	NavStatus  *ubx.NavStatus
+	NavSig     *ubx.NavSig
+	Flags      EpochFlags
+	Expected   EpochFlags
+}
+
+// Complete tells if the epoch was closed by its NAV-EOE and holds every
+// expected message
+func (e *Epoch) Complete() bool {
+	return e.Flags&EpochNavEoe != 0 && e.Missing() == 0
+}
+
+// Missing returns the expected messages that didn't arrive
+func (e *Epoch) Missing() EpochFlags {
+	return e.Expected &^ e.Flags
+}
+
+type EpochAssemblerOption func(*EpochAssembler)
+
+// WithExpectedMessages sets the messages a complete epoch holds,
+// DefaultEpochMessages otherwise
+func WithExpectedMessages(expected EpochFlags) EpochAssemblerOption {
+	return func(a *EpochAssembler) {
+		a.expected = expected | EpochNavEoe
+	}
+}
+
+// EpochAssembler groups the navigation messages by iTOW and hands every
+// epoch to handle. An epoch is closed by the NAV-EOE of its iTOW, or, when
+// the NAV-EOE was lost, by the first message of the next epoch. Other
+// messages are ignored.
+type EpochAssembler struct {
+	lock     sync.Mutex
+	handle   func(*Epoch) error
+	expected EpochFlags
+	current  *Epoch
+}
+
+func NewEpochAssembler(handle func(*Epoch) error, opts ...EpochAssemblerOption) *EpochAssembler {
+	a := &EpochAssembler{
+		handle:   handle,
+		expected: DefaultEpochMessages,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+func (a *EpochAssembler) HandleUbxMessage(msg interface{}) error {
+	var iTOW uint32
+	var flag EpochFlags
+	switch m := msg.(type) {
+	case *ubx.NavPvt:
+		iTOW, flag = m.ITOW_ms, EpochNavPvt
+	case *ubx.NavCov:
+		iTOW, flag = m.ITOW_ms, EpochNavCov
+	case *ubx.NavDop:
+		iTOW, flag = m.ITOW_ms, EpochNavDop
+	case *ubx.NavPosecef:
+		iTOW, flag = m.ITOW_ms, EpochNavPosecef
+	case *ubx.NavTimegps:
+		iTOW, flag = m.ITOW_ms, EpochNavTimegps
+	case *ubx.NavVelecef:
+		iTOW, flag = m.ITOW_ms, EpochNavVelecef
+	case *ubx.NavStatus:
+		iTOW, flag = m.ITOW_ms, EpochNavStatus
+	case *ubx.NavSig:
+		iTOW, flag = m.ITOW_ms, EpochNavSig
+	case *ubx.NavEoe:
+		iTOW, flag = m.ITOW_ms, EpochNavEoe
+	default:
+		return nil
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.current != nil && a.current.ITOW != iTOW {
+		if err := a.close(); err != nil {
+			return err
+		}
+	}
+	if a.current == nil {
+		a.current = &Epoch{
+			ITOW:       iTOW,
+			SystemTime: time.Now().UTC(),
+			Expected:   a.expected,
+		}
+	}
+
+	e := a.current
+	e.Flags |= flag
+	switch m := msg.(type) {
+	case *ubx.NavPvt:
+		e.NavPvt = m
+	case *ubx.NavCov:
+		e.NavCov = m
+	case *ubx.NavDop:
+		e.NavDop = m
+	case *ubx.NavPosecef:
+		e.NavPosecef = m
+	case *ubx.NavTimegps:
+		e.NavTimegps = m
+	case *ubx.NavVelecef:
+		e.NavVelecef = m
+	case *ubx.NavStatus:
+		e.NavStatus = m
+	case *ubx.NavSig:
+		e.NavSig = m
+	case *ubx.NavEoe:
+		return a.close()
+	}
+	return nil
+}
+
+// Flush hands the pending epoch, if any, to the handler without waiting for
+// its NAV-EOE
+func (a *EpochAssembler) Flush() error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.close()
+}
+
+func (a *EpochAssembler) close() error {
+	e := a.current
+	a.current = nil
+	if e == nil {
+		return nil
+	}
+	return a.handle(e)
+}
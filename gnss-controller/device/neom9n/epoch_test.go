@@ -0,0 +1,105 @@
+package neom9n
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/config"
+	"github.com/Hivemapper/gnss-controller/simulator"
+	"github.com/daedaleanai/ublox/ubx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Epochs(t *testing.T) {
+	receiver := simulator.New(simulator.WithTrajectory(simulator.Trajectory{
+		{Latitude: 45.5, Longitude: -73.6, Altitude: 50},
+		{Time: time.Minute, Latitude: 45.51, Longitude: -73.6, Altitude: 50},
+	}))
+	device := simulatedDevice(t, receiver)
+
+	var lock sync.Mutex
+	var epochs []*Epoch
+	assembler := NewEpochAssembler(func(epoch *Epoch) error {
+		lock.Lock()
+		epochs = append(epochs, epoch)
+		lock.Unlock()
+		return nil
+	})
+
+	runDevice(device, &dataCollector{}, assembler)
+
+	require.Eventually(t, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(epochs) >= 3
+	}, 5*time.Second, 20*time.Millisecond)
+
+	lock.Lock()
+	defer lock.Unlock()
+	// the first epoch may have started before the handlers were registered
+	for _, epoch := range epochs[1:] {
+		assert.True(t, epoch.Complete(), "missing %s", epoch.Missing())
+		assert.Equal(t, DefaultEpochMessages, epoch.Flags, "NAV-DOP isn't in the default profile")
+		assert.Equal(t, epoch.ITOW, epoch.NavPvt.ITOW_ms)
+		assert.Equal(t, epoch.ITOW, epoch.NavVelecef.ITOW_ms)
+		assert.Equal(t, epoch.ITOW, epoch.NavTimegps.ITOW_ms)
+		assert.Greater(t, epoch.NavPosecef.EcefZ_cm, int32(0), "northern hemisphere")
+	}
+	assert.Equal(t, uint32(250), epochs[2].ITOW-epochs[1].ITOW)
+}
+
+func Test_EpochAssembler(t *testing.T) {
+	lowPower, ok := config.Builtin("low-power")
+	require.True(t, ok)
+	items, err := lowPower.Items()
+	require.NoError(t, err)
+	expected := ExpectedEpochMessages(items)
+	assert.Equal(t, "NAV-PVT|NAV-TIMEGPS|NAV-STATUS|NAV-EOE", expected.String())
+
+	tests := []struct {
+		name     string
+		messages []ubx.Message
+		flags    []EpochFlags
+		complete []bool
+	}{
+		{
+			name:     "closed by nav-eoe",
+			messages: []ubx.Message{&ubx.NavPvt{ITOW_ms: 1000}, &ubx.NavTimegps{ITOW_ms: 1000}, &ubx.NavStatus{ITOW_ms: 1000}, &ubx.NavEoe{ITOW_ms: 1000}},
+			flags:    []EpochFlags{expected},
+			complete: []bool{true},
+		},
+		{
+			name:     "nav-eoe lost",
+			messages: []ubx.Message{&ubx.NavPvt{ITOW_ms: 1000}, &ubx.NavTimegps{ITOW_ms: 1000}, &ubx.NavStatus{ITOW_ms: 1000}, &ubx.NavPvt{ITOW_ms: 2000}},
+			flags:    []EpochFlags{EpochNavPvt | EpochNavTimegps | EpochNavStatus, EpochNavPvt},
+			complete: []bool{false, false},
+		},
+		{
+			name:     "message lost",
+			messages: []ubx.Message{&ubx.NavPvt{ITOW_ms: 1000}, &ubx.NavStatus{ITOW_ms: 1000}, &ubx.MonRf{}, &ubx.NavEoe{ITOW_ms: 1000}},
+			flags:    []EpochFlags{EpochNavPvt | EpochNavStatus | EpochNavEoe},
+			complete: []bool{false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var epochs []*Epoch
+			assembler := NewEpochAssembler(func(epoch *Epoch) error {
+				epochs = append(epochs, epoch)
+				return nil
+			}, WithExpectedMessages(expected))
+			for _, msg := range tt.messages {
+				require.NoError(t, assembler.HandleUbxMessage(msg))
+			}
+			require.NoError(t, assembler.Flush())
+
+			require.Len(t, epochs, len(tt.flags))
+			for i, epoch := range epochs {
+				assert.Equal(t, tt.flags[i], epoch.Flags)
+				assert.Equal(t, tt.complete[i], epoch.Complete())
+			}
+		})
+	}
+}
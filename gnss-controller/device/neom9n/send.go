@@ -0,0 +1,93 @@
+package neom9n
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/message"
+	"github.com/daedaleanai/ublox/ubx"
+)
+
+const (
+	defaultSendTimeout = 500 * time.Millisecond
+	defaultSendRetries = 2
+)
+
+var errClosed = errors.New("neom9n closed")
+
+// WithSendTimeout waits up to timeout for the acknowledgement of a message
+// sent with Send before sending it again
+func WithSendTimeout(timeout time.Duration) Option {
+	return func(n *Neom9n) {
+		n.sendTimeout = timeout
+	}
+}
+
+// WithSendRetries sends a message retries more times when it isn't
+// acknowledged in time
+func WithSendRetries(retries int) Option {
+	return func(n *Neom9n) {
+		n.sendRetries = retries
+	}
+}
+
+// Send writes msg to the receiver and waits for the ACK-ACK of its class/ID,
+// other acknowledgements don't count. It fails with message.ErrNak when the
+// receiver rejects msg. msg is sent again when it isn't acknowledged in time,
+// Send fails with message.ErrAckTimeout once the retries are exhausted.
+func (n *Neom9n) Send(ctx context.Context, msg ubx.Message) error {
+	return n.send(ctx, msg, n.sendTimeout, n.sendRetries)
+}
+
+func (n *Neom9n) send(ctx context.Context, msg ubx.Message, timeout time.Duration, retries int) error {
+	decoder, _ := n.connection()
+	if decoder == nil {
+		return fmt.Errorf("sending %T: receiver not initialized", msg)
+	}
+	frame, err := ubx.Encode(msg)
+	if err != nil {
+		return fmt.Errorf("encoding %T: %w", msg, err)
+	}
+	classID := uint16(frame[2]) | uint16(frame[3])<<8
+
+	acked, cancel := decoder.ExpectAck(classID)
+	// the attempts sent without an acknowledgement yet, theirs may come late
+	unacked := 0
+	defer func() {
+		cancel(unacked)
+	}()
+
+	for attempt := 0; attempt <= retries; attempt++ {
+		select {
+		case n.output <- msg:
+			unacked++
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-n.closed:
+			return fmt.Errorf("sending %T: %w", msg, errClosed)
+		case <-decoder.Terminating():
+			return fmt.Errorf("sending %T: decoder stopped", msg)
+		}
+
+		timer := time.NewTimer(timeout)
+		select {
+		case ack := <-acked:
+			timer.Stop()
+			unacked--
+			if !ack {
+				return fmt.Errorf("sending %T: %w", msg, message.ErrNak)
+			}
+			return nil
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-decoder.Terminating():
+			timer.Stop()
+			return fmt.Errorf("sending %T: decoder stopped", msg)
+		}
+	}
+	return fmt.Errorf("sending %T, %d attempts: %w", msg, retries+1, message.ErrAckTimeout)
+}
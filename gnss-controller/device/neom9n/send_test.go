@@ -0,0 +1,49 @@
+package neom9n
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/message"
+	"github.com/Hivemapper/gnss-controller/simulator"
+	"github.com/daedaleanai/ublox/ubx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Send(t *testing.T) {
+	receiver := simulator.New(simulator.WithRejectedKeys(ubx.CfgKeyNavspgDynmodel))
+	device := simulatedDevice(t, receiver, WithSendTimeout(100*time.Millisecond), WithSendRetries(1))
+
+	valSet := func(key ubx.CfgKeyID, value []byte) ubx.Message {
+		return &ubx.CfgValSet{Layers: ubx.CfgValSetLayersRam, CfgData: []*ubx.CfgData{{Key: key, Value: value}}}
+	}
+	ctx := context.Background()
+
+	require.NoError(t, device.Send(ctx, valSet(ubx.CfgKeyRateMeas, []byte{0xe8, 0x03})))
+	value, _ := receiver.Value(simulator.LayerRam, ubx.CfgKeyRateMeas)
+	assert.Equal(t, []byte{0xe8, 0x03}, value)
+
+	err := device.Send(ctx, valSet(ubx.CfgKeyNavspgDynmodel, []byte{4}))
+	assert.True(t, errors.Is(err, message.ErrNak), "got %v", err)
+
+	// the simulator doesn't answer MON-VER, the ACKs of the CFG-VALSET sent
+	// meanwhile aren't its own
+	done := make(chan error, 1)
+	start := time.Now()
+	go func() {
+		done <- device.Send(ctx, &ubx.MonVer{})
+	}()
+	for i := 0; i < 4; i++ {
+		require.NoError(t, device.Send(ctx, valSet(ubx.CfgKeyRateNav, []byte{1, 0})))
+	}
+	err = <-done
+	assert.True(t, errors.Is(err, message.ErrAckTimeout), "got %v", err)
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond, "sent twice")
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Equal(t, context.Canceled, device.Send(canceled, &ubx.MonVer{}))
+}
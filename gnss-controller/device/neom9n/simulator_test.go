@@ -0,0 +1,119 @@
+package neom9n
+
+import (
+	"crypto/sha256"
+	b64 "encoding/base64"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/message"
+	"github.com/Hivemapper/gnss-controller/simulator"
+	"github.com/daedaleanai/ublox/ubx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// simulatedDevice returns a device initialized with receiver over an in
+// process transport, closed at the end of the test
+func simulatedDevice(t *testing.T, receiver *simulator.Receiver, opts ...Option) *Neom9n {
+	opts = append([]Option{WithTransport(simulator.NewTransport(receiver, simulator.DefaultBaudRate))}, opts...)
+	device := NewNeom9n("", "", simulator.DefaultBaudRate, false, opts...)
+	require.NoError(t, device.Init(nil))
+	t.Cleanup(func() {
+		_ = device.Close()
+	})
+	return device
+}
+
+// runDevice runs device until it's closed, the returned channel receives
+// the reason it stopped
+func runDevice(device *Neom9n, data *dataCollector, ubxHandler message.UbxMessageHandler) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		done <- device.Run(NewDataFeed(data.handle), ubxHandler, ubxHandler != nil)
+	}()
+	return done
+}
+
+// dataCollector keeps a copy of every data handed over by the data feed
+type dataCollector struct {
+	lock sync.Mutex
+	data []Data
+}
+
+func (c *dataCollector) handle(data *Data) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.data = append(c.data, *data)
+}
+
+// waitForSigned waits until count data with a SEC-ECSIGN were collected and
+// returns them
+func (c *dataCollector) waitForSigned(t *testing.T, count int) []Data {
+	require.Eventually(t, func() bool {
+		return len(c.signed()) >= count
+	}, 5*time.Second, 20*time.Millisecond)
+	return c.signed()
+}
+
+func (c *dataCollector) signed() []Data {
+	var signed []Data
+	for _, data := range c.collected() {
+		if data.SecEcsign != nil {
+			signed = append(signed, data)
+		}
+	}
+	return signed
+}
+
+func (c *dataCollector) collected() []Data {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return append([]Data(nil), c.data...)
+}
+
+func Test_SimulatedReceiver(t *testing.T) {
+	receiver := simulator.New(simulator.WithTrajectory(simulator.Trajectory{
+		{Latitude: 45.5, Longitude: -73.6, Altitude: 50},
+		{Time: time.Minute, Latitude: 45.51, Longitude: -73.6, Altitude: 50},
+	}))
+	device := simulatedDevice(t, receiver)
+
+	assert.Equal(t, 921600, receiver.BaudRate(), "the baud rate is switched")
+	rateMeas, ok := receiver.Value(simulator.LayerRam, 0x30210001)
+	require.True(t, ok)
+	assert.Equal(t, []byte{250, 0}, rateMeas, "CFG-RATE-MEAS is set")
+
+	data := &dataCollector{}
+	collector := &navPvtCollector{}
+	runDevice(device, data, collector)
+
+	signed := data.waitForSigned(t, 2)
+	for _, data := range signed {
+		buffer, err := b64.StdEncoding.DecodeString(data.SecEcsignBuffer)
+		require.NoError(t, err)
+		assert.Equal(t, sha256.Sum256(buffer), data.SecEcsign.FinalHash, "the hash covers every frame since the previous SEC-ECSIGN")
+	}
+	last := signed[len(signed)-1]
+
+	assert.Equal(t, "Fix3D", last.Fix)
+	assert.True(t, last.GnssFixOk)
+	assert.InDelta(t, 45.5, last.Position.Latitude, 0.001)
+	assert.InDelta(t, -73.6, last.Position.Longitude, 1e-6)
+	assert.InDelta(t, 50, last.Position.Altitude, 1e-3)
+	assert.Greater(t, last.Velocity.North, 0.0, "heading north")
+	assert.InDelta(t, last.Velocity.North, last.Speed, 1e-3)
+	assert.Equal(t, Satellites{Seen: 9, Used: 9}, last.Satellites)
+	assert.InDelta(t, 1.5, last.HorizontalAccuracy, 1e-9)
+	assert.InDelta(t, 1.2, last.Dop.PDop, 1e-9, "from NAV-PVT, NAV-DOP isn't in the default profile")
+	assert.False(t, last.Timestamp.IsZero())
+
+	collector.lock.Lock()
+	defer collector.lock.Unlock()
+	require.GreaterOrEqual(t, len(collector.itows), 2)
+	assert.Equal(t, uint32(250), collector.itows[1]-collector.itows[0], "one solution per measurement")
+	assert.Equal(t, byte(ubx.NavPvtFix3D), collector.last.FixType)
+	assert.InDelta(t, 45.5, float64(collector.last.Lat_dege7)/1e7, 0.001)
+	assert.Greater(t, collector.last.VelN_mm_s, int32(0), "heading north")
+}
@@ -0,0 +1,117 @@
+package message
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrNak is returned for a message the receiver answers with ACK-NAK
+	ErrNak = errors.New("not acknowledged by the receiver")
+	// ErrAckTimeout is returned for a message neither ACKed nor NAKed in time
+	ErrAckTimeout = errors.New("no acknowledgement from the receiver")
+)
+
+// staleAckWindow is how long the late acknowledgement of a message its
+// sender gave up on is expected, after which it's considered lost
+const staleAckWindow = 2 * time.Second
+
+// ackWaiters hands the ACK-ACK and ACK-NAK of the class/ID of a message to
+// the oldest sender waiting for it. The acknowledgements don't tell which
+// message they answer: the late ones of the messages a sender gave up on are
+// dropped, they would otherwise answer the next message of the class/ID.
+type ackWaiters struct {
+	lock    sync.Mutex
+	waiters map[uint16][]chan bool
+	// stale are the expiry times of the late acknowledgements to drop
+	stale map[uint16][]time.Time
+}
+
+func (a *ackWaiters) add(classID uint16) chan bool {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.waiters == nil {
+		a.waiters = map[uint16][]chan bool{}
+	}
+	acked := make(chan bool, 1)
+	a.waiters[classID] = append(a.waiters[classID], acked)
+	return acked
+}
+
+// remove stops waiting, unacked is the number of messages sent that weren't
+// acknowledged
+func (a *ackWaiters) remove(classID uint16, acked chan bool, unacked int) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if unacked > 0 {
+		if a.stale == nil {
+			a.stale = map[uint16][]time.Time{}
+		}
+		expiry := time.Now().Add(staleAckWindow)
+		for i := 0; i < unacked; i++ {
+			a.stale[classID] = append(a.stale[classID], expiry)
+		}
+	}
+
+	waiters := a.waiters[classID]
+	for i, waiter := range waiters {
+		if waiter == acked {
+			a.waiters[classID] = append(waiters[:i:i], waiters[i+1:]...)
+			break
+		}
+	}
+	if len(a.waiters[classID]) == 0 {
+		delete(a.waiters, classID)
+	}
+}
+
+// deliver returns false when nobody waits for the acknowledgement
+func (a *ackWaiters) deliver(classID uint16, ack bool) bool {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.dropStale(classID, time.Now()) {
+		return true
+	}
+	waiters := a.waiters[classID]
+	if len(waiters) == 0 {
+		return false
+	}
+	waiters[0] <- ack
+	if len(waiters) == 1 {
+		delete(a.waiters, classID)
+	} else {
+		a.waiters[classID] = waiters[1:]
+	}
+	return true
+}
+
+// dropStale tells if the acknowledgement of classID is a late one, expected
+// by nobody anymore
+func (a *ackWaiters) dropStale(classID uint16, now time.Time) bool {
+	stale := a.stale[classID]
+	for len(stale) > 0 && now.After(stale[0]) {
+		stale = stale[1:]
+	}
+	if len(stale) == 0 {
+		delete(a.stale, classID)
+		return false
+	}
+	if len(stale) == 1 {
+		delete(a.stale, classID)
+	} else {
+		a.stale[classID] = stale[1:]
+	}
+	return true
+}
+
+// ExpectAck registers a wait for the acknowledgement of the next message of
+// classID (class in the low byte, ID in the high byte, as ubx encodes them).
+// It must be called before the message is sent. The returned channel
+// receives true on ACK-ACK and false on ACK-NAK; cancel stops waiting,
+// unacked is the number of messages sent that weren't acknowledged: their
+// acknowledgement may still come and isn't handed to the next sender.
+func (d *Decoder) ExpectAck(classID uint16) (acked <-chan bool, cancel func(unacked int)) {
+	waiter := d.acks.add(classID)
+	return waiter, func(unacked int) { d.acks.remove(classID, waiter, unacked) }
+}
@@ -0,0 +1,54 @@
+package message
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const classCfgValset = 0x8a06
+
+func received(acked <-chan bool) (bool, bool) {
+	select {
+	case ack := <-acked:
+		return ack, true
+	default:
+		return false, false
+	}
+}
+
+func Test_AckAfterTimeout(t *testing.T) {
+	acks := &ackWaiters{}
+
+	// the first CFG-VALSET is sent twice and given up on
+	first := acks.add(classCfgValset)
+	acks.remove(classCfgValset, first, 2)
+
+	second := acks.add(classCfgValset)
+	require.True(t, acks.deliver(classCfgValset, false), "late NAK of the first CFG-VALSET")
+	require.True(t, acks.deliver(classCfgValset, true), "late ACK of its retry")
+	_, ok := received(second)
+	assert.False(t, ok, "the late acknowledgements aren't the ones of the second CFG-VALSET")
+
+	require.True(t, acks.deliver(classCfgValset, true))
+	ack, ok := received(second)
+	assert.True(t, ok && ack)
+	acks.remove(classCfgValset, second, 0)
+
+	assert.False(t, acks.deliver(classCfgValset, true), "nobody waits anymore")
+}
+
+func Test_LostAckExpires(t *testing.T) {
+	acks := &ackWaiters{}
+	first := acks.add(classCfgValset)
+	acks.remove(classCfgValset, first, 1)
+	// the acknowledgement of the first CFG-VALSET never came
+	acks.stale[classCfgValset][0] = time.Now().Add(-time.Millisecond)
+
+	second := acks.add(classCfgValset)
+	require.True(t, acks.deliver(classCfgValset, true))
+	ack, ok := received(second)
+	assert.True(t, ok && ack)
+}
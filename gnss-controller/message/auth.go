@@ -0,0 +1,171 @@
+package message
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/daedaleanai/ublox/ubx"
+)
+
+// AuthStatus is the outcome of the verification of a SEC-ECSIGN
+type AuthStatus int
+
+const (
+	// AuthUnknown is a signature which couldn't be checked: no public key is
+	// configured, or the decoder didn't see every frame it covers
+	AuthUnknown AuthStatus = iota
+	// AuthVerified is a signature of the SHA-256 of the frames received,
+	// made with the private key of the receiver
+	AuthVerified
+	// AuthFailed is a hash or a signature which doesn't match
+	AuthFailed
+)
+
+var errHashMismatch = errors.New("hash mismatch")
+
+var authStatusNames = []string{"unknown", "verified", "failed"}
+
+func (s AuthStatus) String() string {
+	if s < 0 || int(s) >= len(authStatusNames) {
+		return fmt.Sprintf("AuthStatus(%d)", int(s))
+	}
+	return authStatusNames[s]
+}
+
+func (s AuthStatus) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+func (s *AuthStatus) UnmarshalText(text []byte) error {
+	for i, name := range authStatusNames {
+		if name == string(text) {
+			*s = AuthStatus(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown auth status %q", text)
+}
+
+var (
+	p192     *elliptic.CurveParams
+	p192Once sync.Once
+)
+
+// P192 returns the NIST P-192 curve, secp192r1, the receiver signs with.
+// crypto/elliptic doesn't provide it.
+func P192() elliptic.Curve {
+	p192Once.Do(func() {
+		p192 = &elliptic.CurveParams{
+			Name:    "P-192",
+			BitSize: 192,
+			P:       hexInt("fffffffffffffffffffffffffffffffeffffffffffffffff"),
+			N:       hexInt("ffffffffffffffffffffffff99def836146bc9b1b4d22831"),
+			B:       hexInt("64210519e59c80e70fa7e9ab72243049feb8deecc146b9b1"),
+			Gx:      hexInt("188da80eb03090f67cbf20eb43a18800f4ff0afd82ff1012"),
+			Gy:      hexInt("07192b95ffc8da78631011ed6b24cdd573f977a11e794811"),
+		}
+	})
+	return p192
+}
+
+func hexInt(s string) *big.Int {
+	i, _ := new(big.Int).SetString(s, 16)
+	return i
+}
+
+// ParsePublicKey parses the P-192 public key of the receiver in hex: X and Y
+// optionally prefixed with 04, or X prefixed with 02 or 03 when compressed.
+func ParsePublicKey(s string) (*ecdsa.PublicKey, error) {
+	data, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(s), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("parsing gnss public key: %w", err)
+	}
+
+	curve := P192()
+	params := curve.Params()
+	var x, y *big.Int
+	switch {
+	case len(data) == 48:
+		x, y = new(big.Int).SetBytes(data[:24]), new(big.Int).SetBytes(data[24:])
+	case len(data) == 49 && data[0] == 0x04:
+		x, y = new(big.Int).SetBytes(data[1:25]), new(big.Int).SetBytes(data[25:])
+	case len(data) == 25 && (data[0] == 0x02 || data[0] == 0x03):
+		// y² = x³ - 3x + b
+		x = new(big.Int).SetBytes(data[1:])
+		y2 := new(big.Int).Exp(x, big.NewInt(3), params.P)
+		y2.Sub(y2, new(big.Int).Mul(x, big.NewInt(3)))
+		y2.Add(y2, params.B)
+		y2.Mod(y2, params.P)
+		y = new(big.Int).ModSqrt(y2, params.P)
+		if y == nil {
+			return nil, fmt.Errorf("parsing gnss public key: not a point of %s", params.Name)
+		}
+		if y.Bit(0) != uint(data[0]&1) {
+			y.Sub(params.P, y)
+		}
+	default:
+		return nil, fmt.Errorf("parsing gnss public key: expected 48, 49 or 25 bytes, got %d", len(data))
+	}
+	if !curve.IsOnCurve(x, y) {
+		return nil, fmt.Errorf("parsing gnss public key: not a point of %s", params.Name)
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+// SignedDigest returns the 24 bytes the receiver signs for a SEC-ECSIGN: the
+// SHA-256 of the final hash and the session ID, with its first and last 8
+// bytes xored together ahead of its 16 middle bytes.
+//
+// Source: NEO-M9N_Integrationmanual_UBX-19015769_C2-Restricted.pdf
+//
+//	Requires ublox NDA unfortunately.
+func SignedDigest(finalHash [32]byte, sessionId [24]byte) []byte {
+	sum := sha256.Sum256(append(finalHash[:], sessionId[:]...))
+	digest := make([]byte, 0, 24)
+	for i := 0; i < 8; i++ {
+		digest = append(digest, sum[i]^sum[24+i])
+	}
+	return append(digest, sum[8:24]...)
+}
+
+// VerifySecEcsign checks the final hash of sign against the SHA-256 of
+// frames, then its signature with publicKey when there is one. The
+// signature is R then S, big endian. The error tells why it failed.
+func VerifySecEcsign(sign *ubx.SecEcsign, frames [][]byte, publicKey *ecdsa.PublicKey) (AuthStatus, error) {
+	hash := sha256.New()
+	for _, frame := range frames {
+		hash.Write(frame)
+	}
+	if sum := hash.Sum(nil); !bytes.Equal(sum, sign.FinalHash[:]) {
+		return AuthFailed, fmt.Errorf("sha256 of %d frames %x isn't the final hash %x: %w", len(frames), sum, sign.FinalHash, errHashMismatch)
+	}
+	if publicKey == nil {
+		return AuthUnknown, nil
+	}
+
+	r := new(big.Int).SetBytes(sign.EcdsaSignature[:24])
+	s := new(big.Int).SetBytes(sign.EcdsaSignature[24:])
+	if !ecdsa.Verify(publicKey, SignedDigest(sign.FinalHash, sign.SessionId), r, s) {
+		return AuthFailed, fmt.Errorf("invalid ecdsa signature of %d frames", len(frames))
+	}
+	return AuthVerified, nil
+}
+
+// VerifyPartialSecEcsign is VerifySecEcsign for frames that may not be all
+// the ones sign covers: the stream was opened after the first ones, or a
+// corrupted frame was dropped. A hash mismatch is unknown rather than failed.
+func VerifyPartialSecEcsign(sign *ubx.SecEcsign, frames [][]byte, publicKey *ecdsa.PublicKey) (AuthStatus, error) {
+	status, err := VerifySecEcsign(sign, frames, publicKey)
+	if errors.Is(err, errHashMismatch) {
+		return AuthUnknown, err
+	}
+	return status, err
+}
@@ -0,0 +1,47 @@
+package message
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParsePublicKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(P192(), rand.Reader)
+	require.NoError(t, err)
+	x := key.X.FillBytes(make([]byte, 24))
+	y := key.Y.FillBytes(make([]byte, 24))
+	compressed := byte(0x02)
+	if key.Y.Bit(0) == 1 {
+		compressed = 0x03
+	}
+
+	tests := []struct {
+		name          string
+		publicKey     string
+		expectedError string
+	}{
+		{"x and y", hex.EncodeToString(append(x, y...)), ""},
+		{"uncompressed", "04" + hex.EncodeToString(append(x, y...)), ""},
+		{"compressed", hex.EncodeToString(append([]byte{compressed}, x...)), ""},
+		{"0x prefix", "0x04" + hex.EncodeToString(append(x, y...)), ""},
+		{"wrong length", hex.EncodeToString(x), "parsing gnss public key: expected 48, 49 or 25 bytes, got 24"},
+		{"not on the curve", hex.EncodeToString(append(x, x...)), "parsing gnss public key: not a point of P-192"},
+		{"not hex", "key", "parsing gnss public key: encoding/hex: invalid byte: U+006B 'k'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publicKey, err := ParsePublicKey(tt.publicKey)
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, publicKey.Equal(&key.PublicKey))
+		})
+	}
+}
@@ -1,93 +1,91 @@
 package message
 
 import (
-	"crypto/sha256"
+	"crypto/ecdsa"
+	"errors"
 	"time"
 
 	"encoding/hex"
 	"fmt"
 	"io"
 	"reflect"
-	"strings"
 
 	"github.com/daedaleanai/ublox"
 	"github.com/daedaleanai/ublox/ubx"
 	"github.com/streamingfast/shutter"
-	"github.com/tarm/serial"
 
 	b64 "encoding/base64"
 )
 
 type Decoder struct {
 	*shutter.Shutter
-	registry            *HandlerRegistry
-	queue               [][]byte
-	MessageAcknowledged bool
+	registry      *HandlerRegistry
+	queue         [][]byte
+	acks          ackWaiters
+	configReplies chan *ubx.CfgValGet
+	rawCapture    *RawCapture
+
+	corruptionLimit int
+	corrupted       int
+
+	publicKey *ecdsa.PublicKey
+	// signed is set once a SEC-ECSIGN is decoded, the first one may cover
+	// frames sent before the stream was opened
+	signed bool
+	// dropped is set when a frame the next SEC-ECSIGN covers isn't queued
+	dropped bool
 }
 
-func NewDecoder(registry *HandlerRegistry) *Decoder {
-	return &Decoder{
-		Shutter:             shutter.New(),
-		registry:            registry,
-		MessageAcknowledged: false,
+// ErrCorruptedStream stops a decoder with a corruption limit, see
+// WithCorruptionLimit
+var ErrCorruptedStream = errors.New("corrupted gps stream")
+
+type DecoderOption func(*Decoder)
+
+// WithPublicKey verifies the signature of the SEC-ECSIGN messages with the
+// public key of the receiver, see ParsePublicKey. Without it only their hash
+// is verified.
+func WithPublicKey(publicKey *ecdsa.PublicKey) DecoderOption {
+	return func(d *Decoder) {
+		d.publicKey = publicKey
 	}
 }
 
-// Source: NEO-M9N_Integrationmanual_UBX-19015769_C2-Restricted.pdf
-//
-//	Requires ublox NDA unfortunately.
-func validateEcdsaSignature(hash [32]byte, sessionId [24]byte, signature [48]byte) {
-	// concatenate hash and session id
-	hashAndId := make([]byte, 0)
-	hashAndId = append(hashAndId, hash[:]...)
-	hashAndId = append(hashAndId, sessionId[:]...)
-
-	// take the sha sum and split it into three parts
-	hashAndIdSha256 := sha256.Sum256(hashAndId)
-	hashAndIdSha256Part1 := hashAndIdSha256[0:8]
-	hashAndIdSha256Part2 := hashAndIdSha256[8:24]
-	hashAndIdSha256Part3 := hashAndIdSha256[24:32]
-
-	// xor the first and third part
-	hashAndIdSha256Xor := [8]byte{}
-	for i := range hashAndIdSha256Xor {
-		hashAndIdSha256Xor[i] = hashAndIdSha256Part1[i] ^ hashAndIdSha256Part3[i]
+// WithCorruptionLimit shuts the decoder down with ErrCorruptedStream after
+// limit corrupted UBX frames in a row, which is what a host reads when its
+// baud rate doesn't match the one of the receiver.
+func WithCorruptionLimit(limit int) DecoderOption {
+	return func(d *Decoder) {
+		d.corruptionLimit = limit
 	}
-
-	// append the xor result to the second part of the sha sum
-	signatureInput := make([]byte, 0)
-	signatureInput = append(signatureInput, hashAndIdSha256Xor[:]...)
-	signatureInput = append(signatureInput, hashAndIdSha256Part2...)
-
-	// TODO: validate signatureInput against the gnss-provided signature
 }
 
-// compute the hash of the queue contents
-func validateHash(sign *ubx.SecEcsign, queue [][]byte) {
-	flattened := make([]byte, 0)
-	for _, elem := range queue {
-		flattened = append(flattened, elem...)
-		if elem[0] == 0xB5 {
-			fmt.Printf("Entry: %v\n", elem)
-		} else {
-			fmt.Printf("NMEA Entry: %v\n", elem)
-		}
+// WithRawCapture writes every frame received, UBX and NMEA, to capture
+func WithRawCapture(capture *RawCapture) DecoderOption {
+	return func(d *Decoder) {
+		d.rawCapture = capture
 	}
-	fmt.Printf("%v messages\n", len(queue))
-	fmt.Printf("flattened bytes, length %v\n", len(flattened))
-
-	sum := sha256.Sum256(flattened)
-	fmt.Printf("sha256sum: %v\n", sum)
-
-	fmt.Printf("finalhash: %v\n", sign.FinalHash)
-	fmt.Printf("messageNum: %v\nsessionId: %v\nversion: %v\n", sign.MsgNum, sign.SessionId, sign.Version)
+}
 
-	fmt.Printf("ecdsa sign: %v", sign.EcdsaSignature)
+func NewDecoder(registry *HandlerRegistry, opts ...DecoderOption) *Decoder {
+	d := &Decoder{
+		Shutter:       shutter.New(),
+		registry:      registry,
+		configReplies: make(chan *ubx.CfgValGet, 4),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
 }
 
 type SecEcsignWithBuffer struct {
 	SecEcsign           *ubx.SecEcsign
 	Base64MessageBuffer string
+	AuthStatus          AuthStatus
+	// Partial tells the buffer may miss frames the signature covers: the
+	// stream was opened after them, or a corrupted frame was dropped
+	Partial bool
 }
 
 func encodeBuffer(buffer [][]byte) string {
@@ -99,30 +97,22 @@ func encodeBuffer(buffer [][]byte) string {
 	return b64.StdEncoding.EncodeToString(flattened)
 }
 
-func (d *Decoder) Decode(stream *serial.Port, config *serial.Config) chan error {
-	done := make(chan error)
-	var ubxDecoder *ublox.Decoder
-
-	initializeDecoder := func() {
-		fmt.Println("=========================")
-		fmt.Println("Initializing decoder...")
-		fmt.Println("=========================")
-		// if stream != nil {
-		// 	fmt.Println("Closing stream");
-		//     stream.Close()
-		// }
-		stream, err := serial.OpenPort(config)
-
-		if err != nil {
-			fmt.Errorf("opening gps serial port: %w", err)
-		}
-		ubxDecoder = ublox.NewDecoder(stream)
-		d.queue = make([][]byte, 0)
-	}
+// Decode reads messages from stream until its end, a read error or until the
+// decoder is shut down. The returned channel receives the reason the decoder
+// stopped, nil at the end of stream. The stream is closed when the decoder is
+// shut down, which unblocks a pending read.
+func (d *Decoder) Decode(stream io.ReadCloser) chan error {
+	done := make(chan error, 1)
+	ubxDecoder := ublox.NewDecoder(stream)
+	d.queue = make([][]byte, 0)
+	d.signed = false
+	d.dropped = false
+
+	d.OnTerminating(func(_ error) {
+		_ = stream.Close()
+	})
 
 	go func() {
-		initializeDecoder()
-
 		for {
 			if d.IsTerminating() || d.IsTerminated() {
 				done <- d.Err()
@@ -132,43 +122,58 @@ func (d *Decoder) Decode(stream *serial.Port, config *serial.Config) chan error
 			//todo: create a cmd to generate a new keypair and store it in the device (for testing purpose). To not loose the public key!
 			//Asymmetric signature (private and public keys):
 			//need to found if we can get back the public keys (I don't think so)
-
-			//todo: cumulate all bytes between 2 UBX-SEC-ECSIGN messages excluding UBX-SEC-ECSIGN messages(to be validated)
-			//todo: get signature from last  UBX-SEC-ECSIGN messages
-			//todo: compute hash of all bytes between 2 UBX-SEC-ECSIGN messages
-			//todo: refactor ubxDecoder.Decode() func to return all bytes with the message so we can compute the hash
-			//todo: signature and computed hash need to be sent with the new data (in the datafeed)
-			//todo: add signature and hash to the json log file in the data logger ...
 			msg, frame, err := ubxDecoder.Decode()
-			if msg == nil {
-				continue
+			if d.rawCapture != nil && frame != nil {
+				if err := d.rawCapture.Write(time.Now().UTC(), frame); err != nil {
+					fmt.Println("WARNING: capturing raw gps frame:", err)
+				}
 			}
 			if err != nil {
-				if err == io.EOF {
-					done <- nil
+				if d.IsTerminating() {
+					continue
+				}
+				if frame == nil {
+					// the stream failed or ended, a truncated last frame is its end
+					if err == io.EOF || err == io.ErrUnexpectedEOF {
+						err = nil
+					} else {
+						err = fmt.Errorf("reading gps stream: %w", err)
+					}
+					done <- err
 					break
 				}
+				// a corrupted frame, the following ones are still good
 				fmt.Println("WARNING: error decoding ubx", err, time.Now())
-				initializeDecoder()
+				d.dropped = true
+				d.countCorrupted()
+				continue
+			}
+			if frame[0] == 0xB5 {
+				d.corrupted = 0
+			}
+			if msg == nil {
+				// NMEA sentences aren't decoded
 				continue
 			}
 			if cfg, ok := msg.(*ubx.CfgValGet); ok {
-				fmt.Println("CFG:", cfg)
+				d.replyConfig(cfg)
 			}
-			if _, ok := msg.(*ubx.AckAck); ok {
-				d.MessageAcknowledged = true
+			if ack, ok := msg.(*ubx.AckAck); ok {
+				d.acks.deliver(uint16(ack.ClsID)|uint16(ack.MsgID)<<8, true)
 			}
 			if nack, ok := msg.(*ubx.AckNak); ok {
-				fmt.Println("NACK:", nack, hex.EncodeToString([]byte{nack.ClsID, nack.MsgID}))
+				if !d.acks.deliver(uint16(nack.ClsID)|uint16(nack.MsgID)<<8, false) {
+					fmt.Println("NACK:", nack, hex.EncodeToString([]byte{nack.ClsID, nack.MsgID}))
+				}
 			}
 
 			if sign, ok := msg.(*ubx.SecEcsign); ok {
-				// validateHash(sign, d.queue)
-
 				// hack: swap the original message for this one, which contains the buffer
 				secEcsignWithBuffer := SecEcsignWithBuffer{}
 				secEcsignWithBuffer.SecEcsign = sign
 				secEcsignWithBuffer.Base64MessageBuffer = encodeBuffer(d.queue)
+				secEcsignWithBuffer.Partial = !d.signed || d.dropped
+				secEcsignWithBuffer.AuthStatus = d.verify(sign, secEcsignWithBuffer.Partial)
 				msg = &secEcsignWithBuffer
 
 				d.queue = make([][]byte, 0)
@@ -188,13 +193,17 @@ func (d *Decoder) Decode(stream *serial.Port, config *serial.Config) chan error
 					mycopy = append(mycopy, []byte("\r\n")...)
 					d.queue = append(d.queue, mycopy)
 				} else {
-					fmt.Printf("Unexpected frame type. This might mess with GNSS authentication")
+					// once per signature, the next SEC-ECSIGN is unknown anyway
+					if !d.dropped {
+						fmt.Println("WARNING: unexpected gnss frame type, the next SEC-ECSIGN can't be verified")
+					}
+					d.dropped = true
 				}
 			}
 			d.registry.ForEachHandler(reflect.TypeOf(msg), func(handler UbxMessageHandler) {
 				err := handler.HandleUbxMessage(msg)
 				if err != nil {
-					done <- err
+					d.Shutdown(fmt.Errorf("handling %T: %w", msg, err))
 				}
 			})
 		}
@@ -202,7 +211,39 @@ func (d *Decoder) Decode(stream *serial.Port, config *serial.Config) chan error
 	return done
 }
 
-func needToRepair(err error) bool {
-	// Check if the error message contains the word "unexpected"
-	return strings.Contains(err.Error(), "unexpected")
+// verify returns the status of sign, which covers the frames in the queue,
+// or only some of them when partial
+func (d *Decoder) verify(sign *ubx.SecEcsign, partial bool) AuthStatus {
+	d.signed = true
+	d.dropped = false
+
+	verify := VerifySecEcsign
+	if partial {
+		verify = VerifyPartialSecEcsign
+	}
+	status, err := verify(sign, d.queue, d.publicKey)
+	if status == AuthFailed {
+		fmt.Println("WARNING: gnss authentication failed:", err)
+	}
+	return status
+}
+
+func (d *Decoder) countCorrupted() {
+	d.corrupted++
+	if d.corruptionLimit > 0 && d.corrupted >= d.corruptionLimit {
+		d.Shutdown(fmt.Errorf("%d corrupted frames in a row: %w", d.corrupted, ErrCorruptedStream))
+	}
+}
+
+// ConfigReplies receives the CFG-VALGET replies, which come before the ACK of
+// the poll. Replies nobody waits for are dropped.
+func (d *Decoder) ConfigReplies() <-chan *ubx.CfgValGet {
+	return d.configReplies
+}
+
+func (d *Decoder) replyConfig(cfg *ubx.CfgValGet) {
+	select {
+	case d.configReplies <- cfg:
+	default:
+	}
 }
@@ -0,0 +1,70 @@
+package message
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"io"
+	"testing"
+
+	"github.com/daedaleanai/ublox/ubx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type statusCollector struct {
+	statuses []AuthStatus
+}
+
+func (c *statusCollector) HandleUbxMessage(msg interface{}) error {
+	c.statuses = append(c.statuses, msg.(*SecEcsignWithBuffer).AuthStatus)
+	return nil
+}
+
+// signedWindow returns the frames followed by their SEC-ECSIGN, signed with
+// key over signedFrames
+func signedWindow(t *testing.T, key *ecdsa.PrivateKey, frames [][]byte, signedFrames [][]byte) []byte {
+	hash := sha256.New()
+	for _, frame := range signedFrames {
+		hash.Write(frame)
+	}
+	sign := &ubx.SecEcsign{Version: 0x01, MsgNum: uint16(len(signedFrames))}
+	copy(sign.FinalHash[:], hash.Sum(nil))
+	r, s, err := ecdsa.Sign(rand.Reader, key, SignedDigest(sign.FinalHash, sign.SessionId))
+	require.NoError(t, err)
+	r.FillBytes(sign.EcdsaSignature[:24])
+	s.FillBytes(sign.EcdsaSignature[24:])
+
+	signFrame, err := ubx.Encode(sign)
+	require.NoError(t, err)
+	return append(bytes.Join(frames, nil), signFrame...)
+}
+
+func Test_DecoderAuthStatus(t *testing.T) {
+	key, err := ecdsa.GenerateKey(P192(), rand.Reader)
+	require.NoError(t, err)
+
+	navPvt, err := ubx.Encode(&ubx.NavPvt{ITOW_ms: 1000})
+	require.NoError(t, err)
+	navDop, err := ubx.Encode(&ubx.NavDop{ITOW_ms: 1000})
+	require.NoError(t, err)
+	corrupted := append([]byte(nil), navDop...)
+	corrupted[10] ^= 0xff
+	frames := [][]byte{navPvt, navDop}
+
+	var stream []byte
+	stream = append(stream, signedWindow(t, key, frames, frames)...)
+	stream = append(stream, signedWindow(t, key, [][]byte{navPvt, corrupted}, frames)...)
+	stream = append(stream, signedWindow(t, key, frames, [][]byte{navPvt})...)
+	stream = append(stream, signedWindow(t, key, frames, frames)...)
+
+	collector := &statusCollector{}
+	registry := NewHandlerRegistry()
+	registry.RegisterHandler(UbxSecEcsignWithBuffer, collector)
+	decoder := NewDecoder(registry, WithPublicKey(&key.PublicKey))
+	require.NoError(t, <-decoder.Decode(io.NopCloser(bytes.NewReader(stream))))
+
+	assert.Equal(t, []AuthStatus{AuthVerified, AuthUnknown, AuthFailed, AuthVerified}, collector.statuses,
+		"a window with a dropped frame is unknown, a wrong hash otherwise fails")
+}
@@ -0,0 +1,247 @@
+package message
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const RawCaptureExtension = ".ubx"
+
+// RawCaptureTimesExtension is appended to the name of a capture file for its
+// sidecar of receive times. The .ubx file only holds the bytes sent by the
+// receiver so u-center and RTKLIB can open it.
+const RawCaptureTimesExtension = ".times"
+
+// rawCaptureTimeEntrySize is the size of a receive time entry:
+// [receive time unix nano int64][frame length uint32]
+const rawCaptureTimeEntrySize = 12
+
+// RawCapture writes every raw frame received from the gnss receiver to
+// rotating .ubx files. A new file is started when the current one reaches
+// maxFileSize, the oldest files are removed to keep at most maxFiles.
+type RawCapture struct {
+	lock sync.Mutex
+
+	dir         string
+	maxFileSize int64
+	maxFiles    int
+
+	file  *os.File
+	times *os.File
+	size  int64
+}
+
+func NewRawCapture(dir string, maxFileSize int64, maxFiles int) *RawCapture {
+	return &RawCapture{
+		dir:         dir,
+		maxFileSize: maxFileSize,
+		maxFiles:    maxFiles,
+	}
+}
+
+// Write records frame as received at receiveTime. NMEA frames are expected
+// without their "\r\n", as returned by the ublox decoder.
+func (c *RawCapture) Write(receiveTime time.Time, frame []byte) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if len(frame) == 0 {
+		return nil
+	}
+	if c.file == nil || c.size >= c.maxFileSize {
+		if err := c.rotate(receiveTime); err != nil {
+			return err
+		}
+	}
+
+	data := frame
+	if frame[0] == '$' {
+		data = append(append(make([]byte, 0, len(frame)+2), frame...), '\r', '\n')
+	}
+
+	entry := make([]byte, rawCaptureTimeEntrySize)
+	binary.BigEndian.PutUint64(entry, uint64(receiveTime.UnixNano()))
+	binary.BigEndian.PutUint32(entry[8:], uint32(len(data)))
+
+	if _, err := c.file.Write(data); err != nil {
+		return fmt.Errorf("writing raw capture: %w", err)
+	}
+	if _, err := c.times.Write(entry); err != nil {
+		return fmt.Errorf("writing raw capture times: %w", err)
+	}
+	c.size += int64(len(data))
+	return nil
+}
+
+func (c *RawCapture) Close() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.closeFiles()
+}
+
+func (c *RawCapture) rotate(now time.Time) error {
+	if err := c.closeFiles(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return fmt.Errorf("creating raw capture directory: %w", err)
+	}
+
+	name := filepath.Join(c.dir, "gnss-"+now.UTC().Format("20060102T150405.000Z")+RawCaptureExtension)
+	file, err := os.Create(name)
+	if err != nil {
+		return fmt.Errorf("creating raw capture file: %w", err)
+	}
+	times, err := os.Create(name + RawCaptureTimesExtension)
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("creating raw capture times file: %w", err)
+	}
+	c.file = file
+	c.times = times
+	c.size = 0
+
+	return c.removeOldFiles()
+}
+
+func (c *RawCapture) removeOldFiles() error {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return fmt.Errorf("listing raw capture directory: %w", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), "gnss-") && strings.HasSuffix(entry.Name(), RawCaptureExtension) {
+			names = append(names, entry.Name())
+		}
+	}
+	// names hold the creation time, sorting them sorts the files by age
+	sort.Strings(names)
+
+	for len(names) > c.maxFiles {
+		path := filepath.Join(c.dir, names[0])
+		fmt.Println("removing raw capture file", path)
+		if err := os.Remove(path); err != nil {
+			return fmt.Errorf("removing raw capture file: %w", err)
+		}
+		_ = os.Remove(path + RawCaptureTimesExtension)
+		names = names[1:]
+	}
+	return nil
+}
+
+func (c *RawCapture) closeFiles() error {
+	if c.file == nil {
+		return nil
+	}
+	fileErr := c.file.Close()
+	timesErr := c.times.Close()
+	c.file = nil
+	c.times = nil
+	if fileErr != nil {
+		return fmt.Errorf("closing raw capture file: %w", fileErr)
+	}
+	if timesErr != nil {
+		return fmt.Errorf("closing raw capture times file: %w", timesErr)
+	}
+	return nil
+}
+
+// RawFileReader reads a .ubx file. When the file has a receive times sidecar
+// the frames are released with their recorded spacing, scaled by speed. A
+// file without one, like a u-center or RTKLIB capture, or a speed of 0 is
+// read as fast as possible.
+type RawFileReader struct {
+	file    *os.File
+	reader  *bufio.Reader
+	times   *bufio.Reader
+	timesFd *os.File
+	speed   float64
+
+	pending   []byte
+	start     time.Time
+	firstTime int64
+}
+
+func OpenRawFile(path string, speed float64) (*RawFileReader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening ubx file: %w", err)
+	}
+
+	r := &RawFileReader{
+		file:   file,
+		reader: bufio.NewReader(file),
+		speed:  speed,
+	}
+	if speed > 0 {
+		times, err := os.Open(path + RawCaptureTimesExtension)
+		if err == nil {
+			r.timesFd = times
+			r.times = bufio.NewReader(times)
+		} else if !os.IsNotExist(err) {
+			_ = file.Close()
+			return nil, fmt.Errorf("opening ubx times file: %w", err)
+		}
+	}
+	return r, nil
+}
+
+func (r *RawFileReader) Read(p []byte) (int, error) {
+	if len(r.pending) == 0 && r.times != nil {
+		if err := r.nextFrame(); err != nil {
+			return 0, err
+		}
+	}
+	if len(r.pending) > 0 {
+		n := copy(p, r.pending)
+		r.pending = r.pending[n:]
+		return n, nil
+	}
+	return r.reader.Read(p)
+}
+
+// nextFrame loads the next frame once its receive time is reached
+func (r *RawFileReader) nextFrame() error {
+	entry := make([]byte, rawCaptureTimeEntrySize)
+	if _, err := io.ReadFull(r.times, entry); err != nil {
+		// frames past the last receive time are released at once
+		r.times = nil
+		return nil
+	}
+	receiveTime := int64(binary.BigEndian.Uint64(entry))
+	length := binary.BigEndian.Uint32(entry[8:])
+
+	if r.start.IsZero() {
+		r.start = time.Now()
+		r.firstTime = receiveTime
+	}
+	elapsed := time.Duration(float64(receiveTime-r.firstTime) / r.speed)
+	if wait := time.Until(r.start.Add(elapsed)); wait > 0 {
+		time.Sleep(wait)
+	}
+
+	frame := make([]byte, length)
+	n, err := io.ReadFull(r.reader, frame)
+	r.pending = frame[:n]
+	if err == io.ErrUnexpectedEOF && n > 0 {
+		return nil
+	}
+	return err
+}
+
+func (r *RawFileReader) Close() error {
+	if r.timesFd != nil {
+		_ = r.timesFd.Close()
+	}
+	return r.file.Close()
+}
@@ -0,0 +1,338 @@
+package simulator
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/daedaleanai/ublox/ubx"
+)
+
+const leapSeconds = 18
+
+var gpsEpoch = time.Date(1980, 1, 6, 0, 0, 0, 0, time.UTC)
+
+const gpsWeek = 7 * 24 * time.Hour
+
+// output is a periodic message, sent every n epochs where n is the value of
+// its CFG-MSGOUT key
+type output struct {
+	key   ubx.CfgKeyID
+	build func(e *epoch) ubx.Message
+}
+
+// outputs are sent in this order, SEC-ECSIGN comes last
+var outputs = []output{
+	{key: ubx.CfgKeyMsgoutUbxRxmSfrbxUart1, build: rxmSfrbx},
+	{key: ubx.CfgKeyMsgoutUbxRxmRawxUart1, build: rxmRawx},
+	{key: ubx.CfgKeyMsgoutUbxNavPvtUart1, build: navPvt},
+	{key: ubx.CfgKeyMsgoutUbxNavPosecefUart1, build: navPosecef},
+	{key: ubx.CfgKeyMsgoutUbxNavTimegpsUart1, build: navTimegps},
+	{key: ubx.CfgKeyMsgoutUbxNavVelecefUart1, build: navVelecef},
+	{key: ubx.CfgKeyMsgoutUbxNavCovUart1, build: navCov},
+	{key: ubx.CfgKeyMsgoutUbxNavDopUart1, build: navDop},
+	{key: ubx.CfgKeyMsgoutUbxNavStatusUart1, build: navStatus},
+	{key: ubx.CfgKeyMsgoutUbxNavSigUart1, build: navSig},
+	{key: ubx.CfgKeyMsgoutUbxNavEoeUart1, build: navEoe},
+	{key: ubx.CfgKeyMsgoutUbxTimTpUart1, build: timTp},
+}
+
+type satellite struct {
+	svId     byte
+	cno      byte
+	distance float64
+	doppler  float64
+}
+
+// satellites are the GPS satellites tracked by the receiver
+var satellites = []satellite{
+	{svId: 2, cno: 42, distance: 21_200_000, doppler: -1200},
+	{svId: 5, cno: 38, distance: 23_400_000, doppler: 850},
+	{svId: 7, cno: 45, distance: 20_300_000, doppler: -300},
+	{svId: 9, cno: 33, distance: 24_900_000, doppler: 2100},
+	{svId: 13, cno: 40, distance: 22_100_000, doppler: -2600},
+	{svId: 16, cno: 36, distance: 23_800_000, doppler: 1500},
+	{svId: 20, cno: 44, distance: 20_800_000, doppler: 400},
+	{svId: 26, cno: 31, distance: 25_100_000, doppler: -1800},
+	{svId: 29, cno: 39, distance: 22_700_000, doppler: 900},
+}
+
+// l1Wavelength is the GPS L1 carrier wavelength in meters
+const l1Wavelength = 0.19029367
+
+// epoch is one navigation solution of the receiver
+type epoch struct {
+	index   int
+	time    time.Time
+	elapsed time.Duration
+	fix     Fix
+}
+
+// gpsTime returns the week and time of week of the epoch
+func (e *epoch) gpsTime() (uint16, time.Duration) {
+	sinceEpoch := e.time.Add(leapSeconds * time.Second).Sub(gpsEpoch)
+	return uint16(sinceEpoch / gpsWeek), sinceEpoch % gpsWeek
+}
+
+func (e *epoch) iTOW() uint32 {
+	_, tow := e.gpsTime()
+	return uint32(tow / time.Millisecond)
+}
+
+func (s *session) runEpochs(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(s.receiver.navPeriod()):
+		}
+		if err := s.writeEpoch(); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *session) writeEpoch() error {
+	r := s.receiver
+	r.lock.Lock()
+	r.epochs++
+	r.elapsed += r.period()
+	e := &epoch{
+		index:   r.epochs,
+		time:    r.start.Add(r.elapsed),
+		elapsed: r.elapsed,
+		fix:     r.trajectory.At(r.elapsed),
+	}
+	rates := make([]uint64, len(outputs))
+	for i, o := range outputs {
+		rates[i] = r.uint(o.key)
+	}
+	signRate := r.uint(ubx.CfgKeyMsgoutUbxSecEcsignUart1)
+	r.lock.Unlock()
+
+	for i, o := range outputs {
+		if rates[i] == 0 || uint64(e.index)%rates[i] != 0 {
+			continue
+		}
+		if err := s.writeMessage(o.build(e)); err != nil {
+			return err
+		}
+	}
+	if signRate != 0 && uint64(e.index)%signRate == 0 {
+		return s.writeSignature()
+	}
+	return nil
+}
+
+func navPvt(e *epoch) ubx.Message {
+	t := e.time
+	height := int32(math.Round(e.fix.Altitude * 1e3))
+	return &ubx.NavPvt{
+		ITOW_ms:       e.iTOW(),
+		Year_y:        uint16(t.Year()),
+		Month_month:   byte(t.Month()),
+		Day_d:         byte(t.Day()),
+		Hour_h:        byte(t.Hour()),
+		Min_min:       byte(t.Minute()),
+		Sec_s:         byte(t.Second()),
+		Valid:         ubx.NavPvtValidDate | ubx.NavPvtValidTime | ubx.NavPvtFullyResolved,
+		TAcc_ns:       20,
+		Nano_ns:       int32(t.Nanosecond()),
+		FixType:       byte(ubx.NavPvtFix3D),
+		Flags:         ubx.NavPvtGnssFixOK,
+		NumSV:         byte(len(satellites)),
+		Lon_dege7:     int32(math.Round(e.fix.Longitude * 1e7)),
+		Lat_dege7:     int32(math.Round(e.fix.Latitude * 1e7)),
+		Height_mm:     height,
+		HMSL_mm:       height, // the geoid is the ellipsoid
+		HAcc_mm:       1500,
+		VAcc_mm:       2500,
+		VelN_mm_s:     int32(math.Round(e.fix.VelocityNorth * 1e3)),
+		VelE_mm_s:     int32(math.Round(e.fix.VelocityEast * 1e3)),
+		VelD_mm_s:     int32(math.Round(e.fix.VelocityDown * 1e3)),
+		GSpeed_mm_s:   int32(math.Round(e.fix.GroundSpeed() * 1e3)),
+		HeadMot_dege5: int32(math.Round(e.fix.Heading() * 1e5)),
+		SAcc_mm_s:     300,
+		HeadAcc_dege5: 50000,
+		PDOP:          120,
+	}
+}
+
+// ecef returns the position of the fix in earth-centered, earth-fixed
+// coordinates, on the same spherical earth as the trajectory
+func (e *epoch) ecef() (float64, float64, float64) {
+	lat, lon := e.fix.Latitude*math.Pi/180, e.fix.Longitude*math.Pi/180
+	r := earthRadius + e.fix.Altitude
+	return r * math.Cos(lat) * math.Cos(lon), r * math.Cos(lat) * math.Sin(lon), r * math.Sin(lat)
+}
+
+func navPosecef(e *epoch) ubx.Message {
+	x, y, z := e.ecef()
+	return &ubx.NavPosecef{
+		ITOW_ms:  e.iTOW(),
+		EcefX_cm: int32(math.Round(x * 100)),
+		EcefY_cm: int32(math.Round(y * 100)),
+		EcefZ_cm: int32(math.Round(z * 100)),
+		PAcc_cm:  290,
+	}
+}
+
+func navTimegps(e *epoch) ubx.Message {
+	week, tow := e.gpsTime()
+	return &ubx.NavTimegps{
+		ITOW_ms: e.iTOW(),
+		FTOW_ns: int32(tow % time.Millisecond),
+		Week:    int16(week),
+		LeapS_s: leapSeconds,
+		Valid:   ubx.NavTimegpsTowValid | ubx.NavTimegpsWeekValid | ubx.NavTimegpsLeapSValid,
+		TAcc_ns: 20,
+	}
+}
+
+// navVelecef rotates the north, east, down velocity of the fix to ECEF
+func navVelecef(e *epoch) ubx.Message {
+	lat, lon := e.fix.Latitude*math.Pi/180, e.fix.Longitude*math.Pi/180
+	n, east, d := e.fix.VelocityNorth, e.fix.VelocityEast, e.fix.VelocityDown
+	x := -math.Sin(lat)*math.Cos(lon)*n - math.Sin(lon)*east - math.Cos(lat)*math.Cos(lon)*d
+	y := -math.Sin(lat)*math.Sin(lon)*n + math.Cos(lon)*east - math.Cos(lat)*math.Sin(lon)*d
+	z := math.Cos(lat)*n - math.Sin(lat)*d
+	return &ubx.NavVelecef{
+		ITOW_ms:     e.iTOW(),
+		EcefVX_cm_s: int32(math.Round(x * 100)),
+		EcefVY_cm_s: int32(math.Round(y * 100)),
+		EcefVZ_cm_s: int32(math.Round(z * 100)),
+		SAcc_cm_s:   30,
+	}
+}
+
+func navCov(e *epoch) ubx.Message {
+	return &ubx.NavCov{
+		ITOW_ms:        e.iTOW(),
+		PosCovValid:    1,
+		VelCovValid:    1,
+		PosCovNN_m2:    2.25,
+		PosCovEE_m2:    2.25,
+		PosCovDD_m2:    6.25,
+		VelCovNN_m2_s2: 0.09,
+		VelCovEE_m2_s2: 0.09,
+		VelCovDD_m2_s2: 0.16,
+	}
+}
+
+func navDop(e *epoch) ubx.Message {
+	return &ubx.NavDop{
+		ITOW_ms: e.iTOW(),
+		GDOP:    150,
+		PDOP:    120,
+		TDOP:    80,
+		VDOP:    100,
+		HDOP:    70,
+		NDOP:    50,
+		EDOP:    50,
+	}
+}
+
+func navStatus(e *epoch) ubx.Message {
+	const ttff = 25 * time.Second
+	return &ubx.NavStatus{
+		ITOW_ms: e.iTOW(),
+		GpsFix:  byte(ubx.NavPvtFix3D),
+		Flags:   ubx.NavStatusGpsFixOk | ubx.NavStatusWknSet | ubx.NavStatusTowSet,
+		Ttff_ms: uint32(ttff / time.Millisecond),
+		Msss_ms: uint32((ttff + e.elapsed) / time.Millisecond),
+	}
+}
+
+func navSig(e *epoch) ubx.Message {
+	sig := &ubx.NavSig{
+		ITOW_ms: e.iTOW(),
+		NumSigs: byte(len(satellites)),
+	}
+	for _, sat := range satellites {
+		sig.Sigs = append(sig.Sigs, &ubx.NavSigSigsType{
+			SvId:       sat.svId,
+			Cno_dbhz:   sat.cno,
+			QualityInd: 7,                                                          // code and carrier locked
+			IonoModel:  1,                                                          // Klobuchar
+			SigFlags:   1 | ubx.NavSigPrUsed | ubx.NavSigCrUsed | ubx.NavSigDoUsed, // healthy
+		})
+	}
+	return sig
+}
+
+// navEoe ends the navigation messages of the epoch
+func navEoe(e *epoch) ubx.Message {
+	return &ubx.NavEoe{ITOW_ms: e.iTOW()}
+}
+
+func rxmRawx(e *epoch) ubx.Message {
+	week, tow := e.gpsTime()
+	lockTime := e.elapsed / time.Millisecond
+	if lockTime > math.MaxUint16 {
+		lockTime = math.MaxUint16
+	}
+
+	rawx := &ubx.RxmRawx{
+		RcvTow_s:   tow.Seconds(),
+		Week_weeks: week,
+		LeapS_s:    leapSeconds,
+		NumMeas:    byte(len(satellites)),
+		RecStat:    ubx.RxmRawxLeapSec,
+		Version:    0x01,
+	}
+	for _, sat := range satellites {
+		pseudorange := sat.distance - sat.doppler*l1Wavelength*e.elapsed.Seconds()
+		rawx.Meas = append(rawx.Meas, &ubx.RxmRawxMeasType{
+			PrMes_m:        pseudorange,
+			CpMes_cycles:   pseudorange / l1Wavelength,
+			DoMes_hz:       float32(sat.doppler),
+			SvId:           sat.svId,
+			Locktime_ms:    uint16(lockTime),
+			Cno_dbhz:       sat.cno,
+			PrStdev_m:      5,
+			CpStdev_cycles: 2,
+			DoStdev_hz:     4,
+			TrkStat:        ubx.RxmRawxPrValid | ubx.RxmRawxCpValid | ubx.RxmRawxHalfCyc,
+		})
+	}
+	return rawx
+}
+
+// rxmSfrbx sends a subframe of one satellite per epoch, the words only carry
+// the TLM preamble and the time of week
+func rxmSfrbx(e *epoch) ubx.Message {
+	sat := satellites[e.index%len(satellites)]
+	sfrbx := &ubx.RxmSfrbx{
+		SvId:     sat.svId,
+		NumWords: 10,
+		Chn:      byte(e.index % len(satellites)),
+		Version:  0x02,
+	}
+	tow := e.iTOW() / 6000 // 6 seconds subframes
+	for i := 0; i < 10; i++ {
+		word := uint32(i) << 6
+		switch i {
+		case 0:
+			word = 0x8b << 22 // TLM preamble
+		case 1:
+			word = (tow & 0x1ffff) << 13
+		}
+		sfrbx.Words = append(sfrbx.Words, &ubx.RxmSfrbxWordsType{Dwrd: word})
+	}
+	return sfrbx
+}
+
+// timTp announces the time pulse of the next second
+func timTp(e *epoch) ubx.Message {
+	week, tow := e.gpsTime()
+	next := (tow/time.Second + 1) * time.Second
+	if next >= gpsWeek {
+		next -= gpsWeek
+		week++
+	}
+	return &ubx.TimTp{
+		TowMS_ms:   uint32(next / time.Millisecond),
+		Week_weeks: week,
+		Flags:      ubx.TimTpUtc,
+	}
+}
@@ -0,0 +1,57 @@
+//go:build linux
+
+package simulator
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// OpenPty creates a pseudo-terminal in raw mode for the receiver to be served
+// on. The returned file is its master side, the host opens the returned path
+// of its slave side, e.g. with a pty:// transport.
+func OpenPty() (*os.File, string, error) {
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		return nil, "", fmt.Errorf("opening /dev/ptmx: %w", err)
+	}
+
+	var unlock int32
+	if err := ioctl(master, syscall.TIOCSPTLCK, uintptr(unsafe.Pointer(&unlock))); err != nil {
+		_ = master.Close()
+		return nil, "", fmt.Errorf("unlocking pty: %w", err)
+	}
+	var number uint32
+	if err := ioctl(master, syscall.TIOCGPTN, uintptr(unsafe.Pointer(&number))); err != nil {
+		_ = master.Close()
+		return nil, "", fmt.Errorf("getting pty number: %w", err)
+	}
+
+	// UBX is binary, nothing may be translated or echoed
+	var termios syscall.Termios
+	if err := ioctl(master, syscall.TCGETS, uintptr(unsafe.Pointer(&termios))); err != nil {
+		_ = master.Close()
+		return nil, "", fmt.Errorf("getting pty attributes: %w", err)
+	}
+	termios.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP | syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON
+	termios.Oflag &^= syscall.OPOST
+	termios.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	termios.Cflag &^= syscall.CSIZE | syscall.PARENB
+	termios.Cflag |= syscall.CS8
+	if err := ioctl(master, syscall.TCSETS, uintptr(unsafe.Pointer(&termios))); err != nil {
+		_ = master.Close()
+		return nil, "", fmt.Errorf("setting pty attributes: %w", err)
+	}
+
+	return master, fmt.Sprintf("/dev/pts/%d", number), nil
+}
+
+func ioctl(file *os.File, request uintptr, arg uintptr) error {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, file.Fd(), request, arg)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
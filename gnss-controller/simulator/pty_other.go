@@ -0,0 +1,13 @@
+//go:build !linux
+
+package simulator
+
+import (
+	"fmt"
+	"os"
+)
+
+// OpenPty is only supported on linux
+func OpenPty() (*os.File, string, error) {
+	return nil, "", fmt.Errorf("pseudo-terminals are only supported on linux")
+}
@@ -0,0 +1,473 @@
+// Package simulator is a simulated u-blox NEO-M9N receiver. It speaks UBX
+// over any stream, so Neom9n can be initialized and run without the device,
+// in tests or on a bench.
+package simulator
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/message"
+	"github.com/daedaleanai/ublox"
+	"github.com/daedaleanai/ublox/ubx"
+)
+
+const DefaultBaudRate = 38400
+
+const (
+	classCfgValSet = 0x8a06
+	classCfgValGet = 0x8b06
+)
+
+// Layer is a configuration layer as numbered by CFG-VALGET
+type Layer byte
+
+const (
+	LayerRam     Layer = 0
+	LayerBbr     Layer = 1
+	LayerFlash   Layer = 2
+	LayerDefault Layer = 7
+)
+
+// valSetLayers maps the layer bits of CFG-VALSET to their layer
+var valSetLayers = map[byte]Layer{
+	0x01: LayerRam,
+	0x02: LayerBbr,
+	0x04: LayerFlash,
+}
+
+// Receiver holds the state of the simulated receiver: its configuration,
+// the baud rate of its UART and the time of its last epoch. The state is kept
+// across the streams it is served on, like a receiver outlives its serial
+// port being reopened.
+type Receiver struct {
+	lock sync.Mutex
+
+	config     map[Layer]map[ubx.CfgKeyID][]byte
+	baudRate   int
+	rejected   map[ubx.CfgKeyID]bool
+	trajectory Trajectory
+	start      time.Time
+	sessionId  [24]byte
+	signingKey *ecdsa.PrivateKey
+
+	epochs  int
+	elapsed time.Duration
+}
+
+type Option func(*Receiver)
+
+// WithBaudRate sets the baud rate of the receiver UART at power on,
+// DefaultBaudRate otherwise.
+func WithBaudRate(baudRate int) Option {
+	return func(r *Receiver) {
+		r.baudRate = baudRate
+	}
+}
+
+// WithTrajectory moves the receiver along trajectory, it stays still in San
+// Francisco otherwise.
+func WithTrajectory(trajectory Trajectory) Option {
+	return func(r *Receiver) {
+		r.trajectory = trajectory
+	}
+}
+
+// WithStartTime sets the UTC time of the start of the trajectory, the time
+// the receiver is created otherwise.
+func WithStartTime(start time.Time) Option {
+	return func(r *Receiver) {
+		r.start = start.UTC()
+	}
+}
+
+// WithRejectedKeys NAKs every CFG-VALSET setting one of keys.
+func WithRejectedKeys(keys ...ubx.CfgKeyID) Option {
+	return func(r *Receiver) {
+		for _, key := range keys {
+			r.rejected[key] = true
+		}
+	}
+}
+
+// WithSigningKey signs the SEC-ECSIGN messages with key, a message.P192
+// key, their signature is left empty otherwise.
+func WithSigningKey(key *ecdsa.PrivateKey) Option {
+	return func(r *Receiver) {
+		r.signingKey = key
+	}
+}
+
+func New(opts ...Option) *Receiver {
+	r := &Receiver{
+		config: map[Layer]map[ubx.CfgKeyID][]byte{
+			LayerRam:     {},
+			LayerBbr:     {},
+			LayerFlash:   {},
+			LayerDefault: {},
+		},
+		baudRate: DefaultBaudRate,
+		rejected: map[ubx.CfgKeyID]bool{},
+		trajectory: Trajectory{
+			{Latitude: 37.7749, Longitude: -122.4194, Altitude: 16},
+		},
+		start: time.Now().UTC(),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	r.config[LayerDefault][ubx.CfgKeyUart1Baudrate] = binary.LittleEndian.AppendUint32(nil, uint32(r.baudRate))
+	r.config[LayerDefault][ubx.CfgKeyRateMeas] = binary.LittleEndian.AppendUint16(nil, 1000)
+	r.config[LayerDefault][ubx.CfgKeyRateNav] = binary.LittleEndian.AppendUint16(nil, 1)
+	_, _ = rand.Read(r.sessionId[:])
+
+	return r
+}
+
+// BaudRate returns the current baud rate of the receiver UART.
+func (r *Receiver) BaudRate() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.baudRate
+}
+
+// SetBaudRate switches the receiver UART to baudRate behind the back of the
+// host, like a reset or another host configuring it.
+func (r *Receiver) SetBaudRate(baudRate int) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.baudRate = baudRate
+}
+
+// Value returns the little endian value of key in layer. The RAM layer falls
+// back to the default value of the keys never set.
+func (r *Receiver) Value(layer Layer, key ubx.CfgKeyID) ([]byte, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.value(layer, key)
+}
+
+func (r *Receiver) value(layer Layer, key ubx.CfgKeyID) ([]byte, bool) {
+	values, ok := r.config[layer]
+	if !ok {
+		return nil, false
+	}
+	if value, ok := values[key]; ok {
+		return value, true
+	}
+	if layer == LayerRam {
+		value, ok := r.config[LayerDefault][key]
+		return value, ok
+	}
+	return nil, false
+}
+
+// uint returns the RAM value of key as an unsigned integer, 0 when unset
+func (r *Receiver) uint(key ubx.CfgKeyID) uint64 {
+	value, _ := r.value(LayerRam, key)
+	var n uint64
+	for i := len(value) - 1; i >= 0; i-- {
+		n = n<<8 | uint64(value[i])
+	}
+	return n
+}
+
+// navPeriod is the time between two navigation solutions
+func (r *Receiver) navPeriod() time.Duration {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.period()
+}
+
+func (r *Receiver) period() time.Duration {
+	meas := r.uint(ubx.CfgKeyRateMeas)
+	if meas == 0 {
+		meas = 1000
+	}
+	nav := r.uint(ubx.CfgKeyRateNav)
+	if nav == 0 {
+		nav = 1
+	}
+	return time.Duration(meas*nav) * time.Millisecond
+}
+
+// Serve talks UBX over stream until the stream ends or fails, or ctx is done.
+// The stream has no baud rate of its own, like a pseudo-terminal or a TCP
+// socket, so it always matches the receiver. The caller closes the stream.
+func (r *Receiver) Serve(ctx context.Context, stream io.ReadWriter) error {
+	return r.serve(ctx, stream, 0)
+}
+
+func (r *Receiver) serve(ctx context.Context, stream io.ReadWriter, hostBaudRate int) error {
+	s := &session{
+		receiver:     r,
+		stream:       stream,
+		hostBaudRate: hostBaudRate,
+		hash:         sha256.New(),
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	epochsDone := make(chan error, 1)
+	go func() {
+		epochsDone <- s.runEpochs(ctx)
+	}()
+	commandsDone := make(chan error, 1)
+	go func() {
+		commandsDone <- s.readCommands()
+	}()
+
+	var err error
+	select {
+	case <-ctx.Done():
+	case err = <-commandsDone:
+	case err = <-epochsDone:
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrClosedPipe) {
+		return nil
+	}
+	return err
+}
+
+// session is the receiver served on one stream
+type session struct {
+	receiver     *Receiver
+	stream       io.ReadWriter
+	hostBaudRate int
+
+	lock     sync.Mutex
+	hash     hash.Hash
+	messages uint16
+}
+
+// baudRateMatches tells if both ends of the stream use the same baud rate
+func (s *session) baudRateMatches() bool {
+	return s.hostBaudRate == 0 || s.hostBaudRate == s.receiver.BaudRate()
+}
+
+func (s *session) readCommands() error {
+	decoder := ublox.NewDecoder(s.stream)
+	for {
+		_, frame, err := decoder.Decode()
+		if frame == nil {
+			return err
+		}
+		if err != nil || frame[0] != 0xB5 || !s.baudRateMatches() {
+			// NMEA, bad checksums and bytes read at the wrong baud rate are dropped
+			continue
+		}
+
+		classID := uint16(frame[2]) | uint16(frame[3])<<8
+		payload := frame[6 : len(frame)-2]
+		switch classID {
+		case classCfgValSet:
+			err = s.handleValSet(frame, payload)
+		case classCfgValGet:
+			err = s.handleValGet(frame, payload)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+type keyValue struct {
+	key   ubx.CfgKeyID
+	value []byte
+}
+
+func parseKeyValues(data []byte) ([]keyValue, error) {
+	var values []keyValue
+	for len(data) > 0 {
+		if len(data) < 4 {
+			return nil, fmt.Errorf("truncated key")
+		}
+		key := ubx.CfgKeyID(binary.LittleEndian.Uint32(data))
+		size := key.Size()
+		if size == 0 {
+			return nil, fmt.Errorf("invalid key %s", key)
+		}
+		if len(data) < 4+size {
+			return nil, fmt.Errorf("truncated value of key %s", key)
+		}
+		values = append(values, keyValue{key: key, value: append([]byte(nil), data[4:4+size]...)})
+		data = data[4+size:]
+	}
+	return values, nil
+}
+
+// handleValSet applies all the values of a CFG-VALSET or none of them
+func (s *session) handleValSet(frame []byte, payload []byte) error {
+	if len(payload) < 4 {
+		return s.acknowledge(frame, false)
+	}
+	values, err := parseKeyValues(payload[4:])
+	if err != nil {
+		return s.acknowledge(frame, false)
+	}
+
+	r := s.receiver
+	r.lock.Lock()
+	for _, kv := range values {
+		if r.rejected[kv.key] {
+			r.lock.Unlock()
+			return s.acknowledge(frame, false)
+		}
+	}
+	for bit, layer := range valSetLayers {
+		if payload[1]&bit == 0 {
+			continue
+		}
+		for _, kv := range values {
+			r.config[layer][kv.key] = kv.value
+		}
+	}
+	baudRate := int(r.uint(ubx.CfgKeyUart1Baudrate))
+	r.lock.Unlock()
+
+	// the ACK still goes out at the previous baud rate
+	if err := s.acknowledge(frame, true); err != nil {
+		return err
+	}
+	r.lock.Lock()
+	r.baudRate = baudRate
+	r.lock.Unlock()
+	return nil
+}
+
+// handleValGet answers a CFG-VALGET poll with the keys found in the layer,
+// wildcards aren't supported
+func (s *session) handleValGet(frame []byte, payload []byte) error {
+	if len(payload) < 4 || (len(payload)-4)%4 != 0 {
+		return s.acknowledge(frame, false)
+	}
+	layer := Layer(payload[1])
+
+	response := []byte{0x01, payload[1], payload[2], payload[3]}
+	found := false
+	s.receiver.lock.Lock()
+	for i := 4; i < len(payload); i += 4 {
+		key := ubx.CfgKeyID(binary.LittleEndian.Uint32(payload[i:]))
+		if value, ok := s.receiver.value(layer, key); ok {
+			response = binary.LittleEndian.AppendUint32(response, uint32(key))
+			response = append(response, value...)
+			found = true
+		}
+	}
+	s.receiver.lock.Unlock()
+
+	if !found {
+		return s.acknowledge(frame, false)
+	}
+	if err := s.writeFrame(encodeFrame(classCfgValGet, response)); err != nil {
+		return err
+	}
+	return s.acknowledge(frame, true)
+}
+
+func (s *session) acknowledge(frame []byte, ack bool) error {
+	var msg ubx.Message = &ubx.AckAck{ClsID: frame[2], MsgID: frame[3]}
+	if !ack {
+		msg = &ubx.AckNak{ClsID: frame[2], MsgID: frame[3]}
+	}
+	return s.writeMessage(msg)
+}
+
+func (s *session) writeMessage(msg ubx.Message) error {
+	frame, err := ubx.Encode(msg)
+	if err != nil {
+		return fmt.Errorf("encoding %T: %w", msg, err)
+	}
+	return s.writeFrame(frame)
+}
+
+// writeFrame sends frame and adds it to the hash signed by the next
+// SEC-ECSIGN
+func (s *session) writeFrame(frame []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.hash.Write(frame)
+	s.messages++
+	return s.send(frame)
+}
+
+// writeSignature sends a SEC-ECSIGN of the frames sent since the previous
+// one, signed with the signing key of the receiver if it has one.
+func (s *session) writeSignature() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	sign := &ubx.SecEcsign{
+		Version:   0x01,
+		MsgNum:    s.messages,
+		SessionId: s.receiver.sessionId,
+	}
+	copy(sign.FinalHash[:], s.hash.Sum(nil))
+	s.hash.Reset()
+	s.messages = 0
+
+	if key := s.receiver.signingKey; key != nil {
+		sigR, sigS, err := ecdsa.Sign(rand.Reader, key, message.SignedDigest(sign.FinalHash, sign.SessionId))
+		if err != nil {
+			return fmt.Errorf("signing SEC-ECSIGN: %w", err)
+		}
+		sigR.FillBytes(sign.EcdsaSignature[:24])
+		sigS.FillBytes(sign.EcdsaSignature[24:])
+	}
+
+	frame, err := ubx.Encode(sign)
+	if err != nil {
+		return fmt.Errorf("encoding %T: %w", sign, err)
+	}
+	return s.send(frame)
+}
+
+func (s *session) send(frame []byte) error {
+	if !s.baudRateMatches() {
+		frame = scramble(frame)
+	}
+	_, err := s.stream.Write(frame)
+	return err
+}
+
+// scramble turns frame into what the host reads when its baud rate doesn't
+// match. The header is kept so the host still finds the frames, their payload
+// and checksum are garbage: the host sees a run of checksum failures.
+func scramble(frame []byte) []byte {
+	scrambled := make([]byte, len(frame))
+	for i, b := range frame {
+		if i < 6 {
+			scrambled[i] = b
+			continue
+		}
+		scrambled[i] = b ^ 0x5a
+	}
+	return scrambled
+}
+
+// encodeFrame wraps payload in a UBX frame, for the messages ubx.Encode can't
+// encode from raw bytes
+func encodeFrame(classID uint16, payload []byte) []byte {
+	frame := []byte{0xb5, 0x62, byte(classID), byte(classID >> 8), byte(len(payload)), byte(len(payload) >> 8)}
+	frame = append(frame, payload...)
+
+	var x, y byte
+	for _, v := range frame[2:] {
+		x += v
+		y += x
+	}
+	return append(frame, x, y)
+}
@@ -0,0 +1,117 @@
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"time"
+)
+
+const earthRadius = 6371000.0
+
+// metersPerDegree is the length of a degree of latitude
+const metersPerDegree = earthRadius * math.Pi / 180
+
+// Waypoint is the position of the receiver at Time since the start of the
+// simulation.
+type Waypoint struct {
+	Time      time.Duration
+	Latitude  float64
+	Longitude float64
+	Altitude  float64 // meters above the ellipsoid
+}
+
+// Trajectory moves the receiver in a straight line and at a constant speed
+// from one waypoint to the next, the waypoints are ordered by time. The
+// receiver stays still on the first waypoint before its time and on the last
+// one after its time.
+type Trajectory []Waypoint
+
+// Fix is the position and velocity of the receiver on its trajectory.
+// Velocities are in meters per second.
+type Fix struct {
+	Latitude      float64
+	Longitude     float64
+	Altitude      float64
+	VelocityNorth float64
+	VelocityEast  float64
+	VelocityDown  float64
+}
+
+func (f Fix) GroundSpeed() float64 {
+	return math.Hypot(f.VelocityNorth, f.VelocityEast)
+}
+
+// Heading returns the heading of motion in degrees, clockwise from north
+func (f Fix) Heading() float64 {
+	heading := math.Atan2(f.VelocityEast, f.VelocityNorth) * 180 / math.Pi
+	if heading < 0 {
+		heading += 360
+	}
+	return heading
+}
+
+func (t Trajectory) At(elapsed time.Duration) Fix {
+	if len(t) == 0 {
+		return Fix{}
+	}
+	i := sort.Search(len(t), func(i int) bool {
+		return t[i].Time > elapsed
+	})
+	if i == 0 {
+		return Fix{Latitude: t[0].Latitude, Longitude: t[0].Longitude, Altitude: t[0].Altitude}
+	}
+	if i == len(t) {
+		last := t[len(t)-1]
+		return Fix{Latitude: last.Latitude, Longitude: last.Longitude, Altitude: last.Altitude}
+	}
+
+	from, to := t[i-1], t[i]
+	duration := (to.Time - from.Time).Seconds()
+	ratio := (elapsed - from.Time).Seconds() / duration
+	fix := Fix{
+		Latitude:  from.Latitude + (to.Latitude-from.Latitude)*ratio,
+		Longitude: from.Longitude + (to.Longitude-from.Longitude)*ratio,
+		Altitude:  from.Altitude + (to.Altitude-from.Altitude)*ratio,
+	}
+	fix.VelocityNorth = (to.Latitude - from.Latitude) * metersPerDegree / duration
+	fix.VelocityEast = (to.Longitude - from.Longitude) * metersPerDegree * math.Cos(fix.Latitude*math.Pi/180) / duration
+	fix.VelocityDown = -(to.Altitude - from.Altitude) / duration
+	return fix
+}
+
+// LoadTrajectory reads a trajectory from a JSON array of waypoints:
+//
+//	[{"seconds": 0, "latitude": 37.7749, "longitude": -122.4194, "altitude": 16}, ...]
+func LoadTrajectory(path string) (Trajectory, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading trajectory: %w", err)
+	}
+
+	var waypoints []struct {
+		Seconds   float64 `json:"seconds"`
+		Latitude  float64 `json:"latitude"`
+		Longitude float64 `json:"longitude"`
+		Altitude  float64 `json:"altitude"`
+	}
+	if err := json.Unmarshal(data, &waypoints); err != nil {
+		return nil, fmt.Errorf("parsing trajectory: %w", err)
+	}
+
+	trajectory := make(Trajectory, 0, len(waypoints))
+	for _, w := range waypoints {
+		trajectory = append(trajectory, Waypoint{
+			Time:      time.Duration(w.Seconds * float64(time.Second)),
+			Latitude:  w.Latitude,
+			Longitude: w.Longitude,
+			Altitude:  w.Altitude,
+		})
+	}
+	sort.SliceStable(trajectory, func(i, j int) bool {
+		return trajectory[i].Time < trajectory[j].Time
+	})
+	return trajectory, nil
+}
@@ -0,0 +1,55 @@
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+)
+
+// Transport connects to a simulated receiver through an in-memory pipe, it
+// implements transport.Transport and transport.BaudRateSetter. While its baud
+// rate differs from the one of the receiver, the receiver drops what it reads
+// and what it writes is garbage, like over a serial port.
+type Transport struct {
+	receiver *Receiver
+
+	lock     sync.Mutex
+	baudRate int
+}
+
+func NewTransport(receiver *Receiver, baudRate int) *Transport {
+	return &Transport{
+		receiver: receiver,
+		baudRate: baudRate,
+	}
+}
+
+func (t *Transport) Open() (io.ReadWriteCloser, error) {
+	host, device := net.Pipe()
+	baudRate := t.BaudRate()
+	go func() {
+		if err := t.receiver.serve(context.Background(), device, baudRate); err != nil {
+			fmt.Println("simulated receiver:", err)
+		}
+		_ = device.Close()
+	}()
+	return host, nil
+}
+
+func (t *Transport) SetBaudRate(baudRate int) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.baudRate = baudRate
+}
+
+func (t *Transport) BaudRate() int {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.baudRate
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("simulated receiver at %d baud", t.BaudRate())
+}
@@ -0,0 +1,40 @@
+package transport
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/Hivemapper/gnss-controller/message"
+)
+
+// File reads a .ubx capture, see message.RawFileReader for the pacing. There
+// is no receiver behind it: writes are dropped and the receiver can't be
+// configured.
+type File struct {
+	path  string
+	speed float64
+}
+
+func NewFile(path string, speed float64) *File {
+	return &File{path: path, speed: speed}
+}
+
+func (f *File) Open() (io.ReadWriteCloser, error) {
+	reader, err := message.OpenRawFile(f.path, f.speed)
+	if err != nil {
+		return nil, err
+	}
+	return &readOnlyStream{RawFileReader: reader}, nil
+}
+
+func (f *File) String() string {
+	return fmt.Sprintf("file %s at speed %g", f.path, f.speed)
+}
+
+type readOnlyStream struct {
+	*message.RawFileReader
+}
+
+func (s *readOnlyStream) Write(p []byte) (int, error) {
+	return len(p), nil
+}
@@ -0,0 +1,29 @@
+package transport
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// Pty opens a device as is, without configuring its line. It is meant for
+// pseudo-terminals, e.g. created by socat or the receiver simulator.
+type Pty struct {
+	path string
+}
+
+func NewPty(path string) *Pty {
+	return &Pty{path: path}
+}
+
+func (p *Pty) Open() (io.ReadWriteCloser, error) {
+	file, err := os.OpenFile(p.path, os.O_RDWR, 0)
+	if err != nil {
+		return nil, fmt.Errorf("opening gps pty: %w", err)
+	}
+	return file, nil
+}
+
+func (p *Pty) String() string {
+	return "pty " + p.path
+}
@@ -0,0 +1,43 @@
+package transport
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/tarm/serial"
+)
+
+type Serial struct {
+	config *serial.Config
+}
+
+func NewSerial(name string, baudRate int) *Serial {
+	return &Serial{
+		config: &serial.Config{
+			Name:     name,
+			Baud:     baudRate,
+			Parity:   serial.ParityNone,
+			StopBits: serial.Stop1,
+		},
+	}
+}
+
+func (s *Serial) Open() (io.ReadWriteCloser, error) {
+	port, err := serial.OpenPort(s.config)
+	if err != nil {
+		return nil, fmt.Errorf("opening gps serial port: %w", err)
+	}
+	return port, nil
+}
+
+func (s *Serial) SetBaudRate(baudRate int) {
+	s.config.Baud = baudRate
+}
+
+func (s *Serial) BaudRate() int {
+	return s.config.Baud
+}
+
+func (s *Serial) String() string {
+	return fmt.Sprintf("serial %s at %d bauds", s.config.Name, s.config.Baud)
+}
@@ -0,0 +1,31 @@
+package transport
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+const tcpDialTimeout = 5 * time.Second
+
+// TCP connects to a receiver exposed over the network, e.g. by ser2net.
+type TCP struct {
+	address string
+}
+
+func NewTCP(address string) *TCP {
+	return &TCP{address: address}
+}
+
+func (t *TCP) Open() (io.ReadWriteCloser, error) {
+	conn, err := net.DialTimeout("tcp", t.address, tcpDialTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to gps at %s: %w", t.address, err)
+	}
+	return conn, nil
+}
+
+func (t *TCP) String() string {
+	return "tcp " + t.address
+}
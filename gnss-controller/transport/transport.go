@@ -0,0 +1,60 @@
+// Package transport opens the byte streams the gnss controller talks UBX
+// over: a serial port, a pseudo-terminal, a TCP socket or a capture file.
+package transport
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Transport opens a stream to the receiver. A stream can be opened again
+// once the previous one is closed.
+type Transport interface {
+	Open() (io.ReadWriteCloser, error)
+	String() string
+}
+
+// BaudRateSetter is implemented by the transports with a baud rate, the new
+// rate is used by the next Open.
+type BaudRateSetter interface {
+	SetBaudRate(baudRate int)
+	BaudRate() int
+}
+
+// Parse returns the transport of address:
+//
+//	/dev/ttyAMA1 or serial:///dev/ttyAMA1  serial port at baudRate
+//	pty:///dev/pts/3                        pseudo-terminal, or any device used as is
+//	tcp://host:port                         TCP socket, e.g. ser2net
+//	file:///path/to/capture.ubx?speed=4     capture file, see File
+func Parse(address string, baudRate int) (Transport, error) {
+	if !strings.Contains(address, "://") {
+		return NewSerial(address, baudRate), nil
+	}
+
+	u, err := url.Parse(address)
+	if err != nil {
+		return nil, fmt.Errorf("parsing gnss transport address: %w", err)
+	}
+	switch u.Scheme {
+	case "serial":
+		return NewSerial(u.Path, baudRate), nil
+	case "pty":
+		return NewPty(u.Path), nil
+	case "tcp":
+		return NewTCP(u.Host), nil
+	case "file":
+		speed := 1.0
+		if value := u.Query().Get("speed"); value != "" {
+			speed, err = strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parsing file transport speed: %w", err)
+			}
+		}
+		return NewFile(u.Path, speed), nil
+	}
+	return nil, fmt.Errorf("unknown gnss transport %q", u.Scheme)
+}
@@ -0,0 +1,147 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Hivemapper/hivemapper-data-logger/data/session"
+	"github.com/gorilla/mux"
+)
+
+type Server struct {
+	state      *State
+	httpServer *http.Server
+}
+
+func NewServer(state *State) *Server {
+	return &Server{
+		state: state,
+	}
+}
+
+func (s *Server) Router() *mux.Router {
+	router := mux.NewRouter()
+	router.HandleFunc("/status", s.handleStatus).Methods(http.MethodGet)
+	router.HandleFunc("/gnss/navpvt", s.handleNavPvt).Methods(http.MethodGet)
+	router.HandleFunc("/gnss/config", s.handleGnssConfig).Methods(http.MethodGet)
+	router.HandleFunc("/imu", s.handleImu).Methods(http.MethodGet)
+	router.HandleFunc("/magnetometer", s.handleMagnetometer).Methods(http.MethodGet)
+	router.HandleFunc("/devices", s.handleDevices).Methods(http.MethodGet)
+	router.HandleFunc("/session", s.handleSession).Methods(http.MethodGet)
+	router.HandleFunc("/rates", s.handleRates).Methods(http.MethodGet)
+	return router
+}
+
+func (s *Server) Start(listenAddr string) error {
+	s.httpServer = &http.Server{
+		Addr:    listenAddr,
+		Handler: s.Router(),
+	}
+
+	fmt.Println("Starting http server on", listenAddr)
+	err := s.httpServer.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("serving http: %w", err)
+	}
+	return nil
+}
+
+func (s *Server) Shutdown(ctx context.Context) error {
+	if s.httpServer == nil {
+		return nil
+	}
+	return s.httpServer.Shutdown(ctx)
+}
+
+type statusResponse struct {
+	SessionID    string                 `json:"session_id"`
+	Uptime       string                 `json:"uptime"`
+	Devices      map[string]DeviceState `json:"devices"`
+	Rates        map[string]float64     `json:"rates"`
+	NavPvt       *NavPvtSample          `json:"nav_pvt"`
+	Imu          *ImuSample             `json:"imu"`
+	Magnetometer *MagnetometerSample    `json:"magnetometer"`
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, _ *http.Request) {
+	sessionID, _ := session.GetSession()
+	writeJson(w, &statusResponse{
+		SessionID:    sessionID,
+		Uptime:       s.state.Uptime().Round(time.Second).String(),
+		Devices:      s.state.Devices(),
+		Rates:        s.state.Rates(),
+		NavPvt:       s.state.LatestNavPvt(),
+		Imu:          s.state.LatestImu(),
+		Magnetometer: s.state.LatestMagnetometer(),
+	})
+}
+
+func (s *Server) handleNavPvt(w http.ResponseWriter, _ *http.Request) {
+	navPvt := s.state.LatestNavPvt()
+	if navPvt == nil {
+		writeError(w, http.StatusNotFound, "no nav pvt received yet")
+		return
+	}
+	writeJson(w, navPvt)
+}
+
+func (s *Server) handleGnssConfig(w http.ResponseWriter, _ *http.Request) {
+	result := s.state.GnssConfig()
+	if result == nil {
+		writeError(w, http.StatusNotFound, "gnss receiver not configured yet")
+		return
+	}
+	writeJson(w, result)
+}
+
+func (s *Server) handleImu(w http.ResponseWriter, _ *http.Request) {
+	imu := s.state.LatestImu()
+	if imu == nil {
+		writeError(w, http.StatusNotFound, "no imu sample received yet")
+		return
+	}
+	writeJson(w, imu)
+}
+
+func (s *Server) handleMagnetometer(w http.ResponseWriter, _ *http.Request) {
+	magnetometer := s.state.LatestMagnetometer()
+	if magnetometer == nil {
+		writeError(w, http.StatusNotFound, "no magnetometer reading received yet")
+		return
+	}
+	writeJson(w, magnetometer)
+}
+
+func (s *Server) handleDevices(w http.ResponseWriter, _ *http.Request) {
+	writeJson(w, s.state.Devices())
+}
+
+func (s *Server) handleSession(w http.ResponseWriter, _ *http.Request) {
+	sessionID, err := session.GetSession()
+	if err != nil {
+		writeError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	writeJson(w, map[string]string{"session_id": sessionID})
+}
+
+func (s *Server) handleRates(w http.ResponseWriter, _ *http.Request) {
+	writeJson(w, s.state.Rates())
+}
+
+func writeJson(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(v)
+	if err != nil {
+		fmt.Printf("writing http response: %s\n", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
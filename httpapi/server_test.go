@@ -0,0 +1,109 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/config"
+	"github.com/Hivemapper/gnss-controller/device/neom9n"
+	"github.com/daedaleanai/ublox/ubx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RateCounter(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := &rateCounter{windowStart: start}
+
+	// 4 Hz for 5 seconds
+	for i := 0; i < 20; i++ {
+		c.increment(start.Add(time.Duration(i) * 250 * time.Millisecond))
+	}
+	assert.Equal(t, 0.0, c.rate(start.Add(4900*time.Millisecond)))
+	before := *c
+	assert.Equal(t, 4.0, c.rate(start.Add(5*time.Second)))
+	assert.Equal(t, before, *c, "reading the rate doesn't move the window")
+
+	// stream stopped
+	assert.Equal(t, 0.0, c.rate(start.Add(20*time.Second)))
+}
+
+func Test_NavPvtEndpoint(t *testing.T) {
+	state := NewState()
+	router := NewServer(state).Router()
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/gnss/navpvt", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	require.NoError(t, state.HandleUbxMessage(&ubx.NavPvt{ITOW_ms: 1234, Lat_dege7: 455000000}))
+	state.SetDeviceState("gnss", nil)
+	state.SetDeviceState("magnetometer", fmt.Errorf("no device"))
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/gnss/navpvt", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var sample NavPvtSample
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &sample))
+	assert.Equal(t, uint32(1234), sample.NavPvt.ITOW_ms)
+	assert.Equal(t, int32(455000000), sample.NavPvt.Lat_dege7)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/devices", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var devices map[string]DeviceState
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &devices))
+	assert.True(t, devices["gnss"].Initialized)
+	assert.False(t, devices["magnetometer"].Initialized)
+	assert.Equal(t, "no device", devices["magnetometer"].Error)
+}
+
+func Test_GnssConfigEndpoint(t *testing.T) {
+	state := NewState()
+	router := NewServer(state).Router()
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/gnss/config", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	state.SetGnssConfig(&neom9n.ConfigResult{
+		Profile: "default",
+		Checks: []neom9n.ConfigCheck{
+			{Name: "CFG-RATE-MEAS", Key: ubx.CfgKeyRateMeas, Layer: config.LayerRam, Expected: []byte{250, 0}, Actual: []byte{250, 0}},
+			{Name: "CFG-NAVSPG-DYNMODEL", Key: ubx.CfgKeyNavspgDynmodel, Layer: config.LayerFlash, Expected: []byte{4}, Written: true},
+		},
+	})
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/gnss/config", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{
+		"profile": "default",
+		"checks": [
+			{"name": "CFG-RATE-MEAS", "layer": "ram", "expected": "250 (0.25 s)", "actual": "250 (0.25 s)", "written": false, "matches": true},
+			{"name": "CFG-NAVSPG-DYNMODEL", "layer": "flash", "expected": "AUTOMOT (4)", "actual": "missing", "written": true, "matches": false}
+		]
+	}`, rec.Body.String())
+}
+
+func Test_DeviceRestarts(t *testing.T) {
+	state := NewState()
+	state.SetDeviceState("imu", nil)
+	state.RecordRestart("imu", 1, fmt.Errorf("getting fifo data: spi error"))
+
+	imu := state.Devices()["imu"]
+	assert.False(t, imu.Initialized)
+	assert.Equal(t, 1, imu.Restarts)
+	assert.Equal(t, "getting fifo data: spi error", imu.Error)
+
+	state.SetDeviceState("imu", nil)
+	imu = state.Devices()["imu"]
+	assert.True(t, imu.Initialized)
+	assert.Equal(t, 1, imu.Restarts)
+}
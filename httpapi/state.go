@@ -0,0 +1,257 @@
+package httpapi
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/device/neom9n"
+	"github.com/Hivemapper/hivemapper-data-logger/data/imu"
+	"github.com/Hivemapper/hivemapper-data-logger/logger"
+	"github.com/daedaleanai/ublox/ubx"
+	"github.com/streamingfast/imu-controller/device/iim42652"
+)
+
+const rateWindow = 5 * time.Second
+
+type NavPvtSample struct {
+	SystemTime time.Time   `json:"system_time"`
+	NavPvt     *ubx.NavPvt `json:"nav_pvt"`
+}
+
+type ImuSample struct {
+	SystemTime   time.Time             `json:"system_time"`
+	Acceleration *imu.Acceleration     `json:"acceleration"`
+	AngularRate  *iim42652.AngularRate `json:"angular_rate"`
+	Temperature  float64               `json:"temperature"`
+}
+
+type MagnetometerSample struct {
+	SystemTime time.Time `json:"system_time"`
+	X          float64   `json:"x"`
+	Y          float64   `json:"y"`
+	Z          float64   `json:"z"`
+}
+
+type DeviceState struct {
+	Initialized bool      `json:"initialized"`
+	Error       string    `json:"error,omitempty"`
+	Time        time.Time `json:"time"`
+	Restarts    int       `json:"restarts"`
+}
+
+// State is a logger.Sink keeping the latest sample of every sensor stream
+// along with message rates so they can be served over http. It is safe for
+// concurrent use.
+type State struct {
+	lock sync.Mutex
+
+	startTime    time.Time
+	navPvt       *NavPvtSample
+	imu          *ImuSample
+	magnetometer *MagnetometerSample
+	devices      map[string]*DeviceState
+	gnssConfig   *neom9n.ConfigResult
+	rates        map[string]*rateCounter
+}
+
+func NewState() *State {
+	return &State{
+		startTime: time.Now().UTC(),
+		devices:   map[string]*DeviceState{},
+		rates:     map[string]*rateCounter{},
+	}
+}
+
+func (s *State) SetDeviceState(name string, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state := &DeviceState{
+		Initialized: err == nil,
+		Time:        time.Now().UTC(),
+	}
+	if err != nil {
+		state.Error = err.Error()
+	}
+	if previous, found := s.devices[name]; found {
+		state.Restarts = previous.Restarts
+	}
+	s.devices[name] = state
+}
+
+// SetGnssConfig keeps the configuration read back from the gnss receiver when
+// it was initialized
+func (s *State) SetGnssConfig(result *neom9n.ConfigResult) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.gnssConfig = result
+}
+
+func (s *State) GnssConfig() *neom9n.ConfigResult {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.gnssConfig
+}
+
+// RecordRestart is a supervisor.RestartHandler, the device is reported as
+// failed until it gets initialized again.
+func (s *State) RecordRestart(name string, restarts int, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.devices[name] = &DeviceState{
+		Error:    err.Error(),
+		Time:     time.Now().UTC(),
+		Restarts: restarts,
+	}
+}
+
+func (s *State) LogImu(record *logger.ImuRecord) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.imu = &ImuSample{
+		SystemTime:   record.SystemTime,
+		Acceleration: record.Acceleration,
+		AngularRate:  record.AngularRate,
+		Temperature:  *record.Temperature,
+	}
+	s.increment("Imu", record.SystemTime)
+	return nil
+}
+
+func (s *State) LogMagnetometer(record *logger.MagnetometerRecord) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.magnetometer = &MagnetometerSample{
+		SystemTime: record.SystemTime,
+		X:          record.X,
+		Y:          record.Y,
+		Z:          record.Z,
+	}
+	s.increment("Magnetometer", record.SystemTime)
+	return nil
+}
+
+func (s *State) HandleUbxMessage(msg interface{}) error {
+	now := time.Now().UTC()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if navPvt, ok := msg.(*ubx.NavPvt); ok {
+		s.navPvt = &NavPvtSample{
+			SystemTime: now,
+			NavPvt:     navPvt,
+		}
+	}
+	s.increment(logger.UbxMessageName(msg), now)
+	return nil
+}
+
+func (s *State) LogGnssAuth(data *neom9n.Data) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.increment("GnssAuthData", data.SystemTime)
+	return nil
+}
+
+func (s *State) Close() error {
+	return nil
+}
+
+func (s *State) LatestNavPvt() *NavPvtSample {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.navPvt
+}
+
+func (s *State) LatestImu() *ImuSample {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.imu
+}
+
+func (s *State) LatestMagnetometer() *MagnetometerSample {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.magnetometer
+}
+
+func (s *State) Devices() map[string]DeviceState {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	devices := make(map[string]DeviceState, len(s.devices))
+	for name, state := range s.devices {
+		devices[name] = *state
+	}
+	return devices
+}
+
+// Rates returns the messages per second of every stream seen so far.
+func (s *State) Rates() map[string]float64 {
+	now := time.Now().UTC()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	rates := make(map[string]float64, len(s.rates))
+	for name, counter := range s.rates {
+		rates[name] = counter.rate(now)
+	}
+	return rates
+}
+
+func (s *State) Uptime() time.Duration {
+	return time.Since(s.startTime)
+}
+
+func (s *State) increment(name string, now time.Time) {
+	counter, found := s.rates[name]
+	if !found {
+		counter = &rateCounter{windowStart: now}
+		s.rates[name] = counter
+	}
+	counter.increment(now)
+}
+
+// rateCounter computes a rate over consecutive windows of rateWindow,
+// reporting the rate of the last complete window.
+type rateCounter struct {
+	windowStart time.Time
+	count       int
+	lastRate    float64
+	lastSeen    time.Time
+}
+
+func (c *rateCounter) increment(now time.Time) {
+	c.roll(now)
+	c.count++
+	c.lastSeen = now
+}
+
+func (c *rateCounter) roll(now time.Time) {
+	elapsed := now.Sub(c.windowStart)
+	if elapsed < rateWindow {
+		return
+	}
+	c.lastRate = float64(c.count) / elapsed.Seconds()
+	c.count = 0
+	c.windowStart = now
+}
+
+// rate reads the counter without rolling its window, only the samples do
+func (c *rateCounter) rate(now time.Time) float64 {
+	// a stream that stopped will not roll its window anymore
+	if now.Sub(c.lastSeen) > rateWindow {
+		return 0
+	}
+	if elapsed := now.Sub(c.windowStart); elapsed >= rateWindow {
+		// the window is complete, the next sample will roll it
+		return float64(c.count) / elapsed.Seconds()
+	}
+	return c.lastRate
+}
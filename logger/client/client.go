@@ -0,0 +1,235 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Hivemapper/hivemapper-data-logger/logger"
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/protobuf/proto"
+)
+
+const defaultTailPollInterval = 100 * time.Millisecond
+const tailListWindow = 1000
+
+// Entry is a decoded record of the data logger. ID is the stream entry ID in
+// streams mode and empty for lists.
+type Entry struct {
+	Key     string
+	ID      string
+	Message proto.Message
+}
+
+type Option func(*Client)
+
+// WithKeyPrefix reads the keys written with --redis-key-prefix
+func WithKeyPrefix(prefix string) Option {
+	return func(c *Client) {
+		c.keyPrefix = prefix
+	}
+}
+
+// WithStreams reads the streams written with --redis-streams instead of lists
+func WithStreams() Option {
+	return func(c *Client) {
+		c.streams = true
+	}
+}
+
+// WithTailPollInterval sets how often lists are polled by Tail
+func WithTailPollInterval(interval time.Duration) Option {
+	return func(c *Client) {
+		c.tailPollInterval = interval
+	}
+}
+
+// Client reads the records written by logger.Redis and decodes them into
+// their sensordata type.
+type Client struct {
+	db               *redis.Client
+	keyPrefix        string
+	streams          bool
+	tailPollInterval time.Duration
+}
+
+func New(db *redis.Client, opts ...Option) *Client {
+	c := &Client{
+		db:               db,
+		tailPollInterval: defaultTailPollInterval,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Latest returns the newest count entries of key, oldest first.
+func (c *Client) Latest(ctx context.Context, key string, count int64) ([]*Entry, error) {
+	return c.Range(ctx, key, 0, count-1)
+}
+
+// Range returns the entries between the start and stop positions, both
+// inclusive, where 0 is the newest entry. Entries are returned oldest first.
+func (c *Client) Range(ctx context.Context, key string, start int64, stop int64) ([]*Entry, error) {
+	if _, err := NewMessage(key); err != nil {
+		return nil, err
+	}
+	if start < 0 || stop < start {
+		return nil, fmt.Errorf("invalid range [%d, %d]", start, stop)
+	}
+
+	if c.streams {
+		messages, err := c.db.XRevRangeN(ctx, c.keyPrefix+key, "+", "-", stop+1).Result()
+		if err != nil {
+			return nil, fmt.Errorf("reading stream %s: %w", key, err)
+		}
+		if int64(len(messages)) <= start {
+			return nil, nil
+		}
+		messages = messages[start:]
+
+		entries := make([]*Entry, 0, len(messages))
+		for i := len(messages) - 1; i >= 0; i-- {
+			entry, err := c.decodeStreamMessage(key, messages[i])
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, entry)
+		}
+		return entries, nil
+	}
+
+	values, err := c.db.LRange(ctx, c.keyPrefix+key, start, stop).Result()
+	if err != nil {
+		return nil, fmt.Errorf("reading list %s: %w", key, err)
+	}
+
+	entries := make([]*Entry, 0, len(values))
+	// lists are LPUSHed, the newest entry is the first one
+	for i := len(values) - 1; i >= 0; i-- {
+		msg, err := Decode(key, []byte(values[i]))
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, &Entry{Key: key, Message: msg})
+	}
+	return entries, nil
+}
+
+// Tail calls handler for every new entry of key, oldest first, until ctx is
+// cancelled or handler fails. Streams are read with a blocking XREAD from
+// lastID ("$" for new entries only), lists are polled and lastID is ignored.
+func (c *Client) Tail(ctx context.Context, key string, lastID string, handler func(entry *Entry) error) error {
+	if _, err := NewMessage(key); err != nil {
+		return err
+	}
+	if c.streams {
+		return c.tailStream(ctx, key, lastID, handler)
+	}
+	return c.tailList(ctx, key, handler)
+}
+
+func (c *Client) tailStream(ctx context.Context, key string, lastID string, handler func(entry *Entry) error) error {
+	if lastID == "" {
+		lastID = "$"
+	}
+	for {
+		streams, err := c.db.XRead(ctx, &redis.XReadArgs{
+			Streams: []string{c.keyPrefix + key, lastID},
+			Block:   time.Second,
+		}).Result()
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err == redis.Nil {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("reading stream %s: %w", key, err)
+		}
+
+		for _, stream := range streams {
+			for _, message := range stream.Messages {
+				entry, err := c.decodeStreamMessage(key, message)
+				if err != nil {
+					return err
+				}
+				if err := handler(entry); err != nil {
+					return err
+				}
+				lastID = message.ID
+			}
+		}
+	}
+}
+
+func (c *Client) tailList(ctx context.Context, key string, handler func(entry *Entry) error) error {
+	var lastHead []byte
+	first := true
+	for {
+		values, err := c.db.LRange(ctx, c.keyPrefix+key, 0, tailListWindow-1).Result()
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading list %s: %w", key, err)
+		}
+
+		newValues := newListValues(values, lastHead)
+		if len(values) > 0 {
+			lastHead = []byte(values[0])
+		}
+		// the entries already there when tailing started are not new
+		if !first {
+			for _, value := range newValues {
+				msg, err := Decode(key, value)
+				if err != nil {
+					return err
+				}
+				if err := handler(&Entry{Key: key, Message: msg}); err != nil {
+					return err
+				}
+			}
+		}
+		first = false
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(c.tailPollInterval):
+		}
+	}
+}
+
+// newListValues returns the values pushed in front of lastHead, oldest first.
+// When lastHead was trimmed away every value is new.
+func newListValues(values []string, lastHead []byte) [][]byte {
+	var newValues [][]byte
+	for _, value := range values {
+		if lastHead != nil && value == string(lastHead) {
+			break
+		}
+		newValues = append(newValues, []byte(value))
+	}
+	for i, j := 0, len(newValues)-1; i < j; i, j = i+1, j-1 {
+		newValues[i], newValues[j] = newValues[j], newValues[i]
+	}
+	return newValues
+}
+
+func (c *Client) decodeStreamMessage(key string, message redis.XMessage) (*Entry, error) {
+	value, found := message.Values[logger.RedisStreamDataField]
+	if !found {
+		return nil, fmt.Errorf("stream entry %s of %s has no %q field", message.ID, key, logger.RedisStreamDataField)
+	}
+	data, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("stream entry %s of %s: unexpected %T data", message.ID, key, value)
+	}
+	msg, err := Decode(key, []byte(data))
+	if err != nil {
+		return nil, err
+	}
+	return &Entry{Key: key, ID: message.ID, Message: msg}, nil
+}
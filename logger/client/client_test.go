@@ -0,0 +1,61 @@
+package client
+
+import (
+	"testing"
+
+	sensordata "github.com/Hivemapper/hivemapper-data-logger/proto-out"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_Decode(t *testing.T) {
+	navPvt := &sensordata.NavPvt{SystemTime: "2024-01-01 00:00:00 +0000 UTC", ItowMs: 1234, LatDege7: 455000000, NumSv: 12}
+	batch := &sensordata.ImuDataBatch{Samples: []*sensordata.ImuData{{Temperature: 25}, {Temperature: 26}}}
+
+	tests := []struct {
+		name    string
+		key     string
+		message proto.Message
+		text    bool
+	}{
+		{name: "binary nav pvt", key: "NavPvt", message: navPvt},
+		{name: "pbtxt nav pvt", key: "NavPvt", message: navPvt, text: true},
+		{name: "binary imu batch", key: "ImuDataBatch", message: batch},
+		{name: "pbtxt imu batch", key: "ImuDataBatch", message: batch, text: true},
+		{name: "binary gnss auth", key: "GnssAuthData", message: &sensordata.GnssData{SecEcsignBuffer: "abc"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var data []byte
+			var err error
+			if test.text {
+				data, err = prototext.Marshal(test.message)
+			} else {
+				data, err = proto.Marshal(test.message)
+			}
+			require.NoError(t, err)
+
+			decoded, err := Decode(test.key, data)
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(test.message, decoded), "got %v", decoded)
+		})
+	}
+}
+
+func Test_DecodeUnknownKey(t *testing.T) {
+	_, err := Decode("NavSat", nil)
+	require.EqualError(t, err, `unknown key "NavSat"`)
+}
+
+func Test_NewListValues(t *testing.T) {
+	// lists are LPUSHed, the newest value comes first
+	values := []string{"d", "c", "b", "a"}
+
+	assert.Equal(t, [][]byte{[]byte("c"), []byte("d")}, newListValues(values, []byte("b")))
+	assert.Empty(t, newListValues(values, []byte("d")))
+	// last head was trimmed away
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("b"), []byte("c"), []byte("d")}, newListValues(values, []byte("z")))
+}
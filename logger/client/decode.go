@@ -0,0 +1,107 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+	"unicode/utf8"
+
+	sensordata "github.com/Hivemapper/hivemapper-data-logger/proto-out"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+// messageTypes maps every key written by logger.Redis to its message type
+var messageTypes = map[string]func() proto.Message{
+	"ImuData":               func() proto.Message { return &sensordata.ImuData{} },
+	"ImuDataBatch":          func() proto.Message { return &sensordata.ImuDataBatch{} },
+	"MagnetometerData":      func() proto.Message { return &sensordata.MagnetometerData{} },
+	"MagnetometerDataBatch": func() proto.Message { return &sensordata.MagnetometerDataBatch{} },
+	"GnssAuthData":          func() proto.Message { return &sensordata.GnssData{} },
+	"NavPvt":                func() proto.Message { return &sensordata.NavPvt{} },
+	"NavDop":                func() proto.Message { return &sensordata.NavDop{} },
+	"NavCov":                func() proto.Message { return &sensordata.NavCov{} },
+	"NavPosecef":            func() proto.Message { return &sensordata.NavPosecef{} },
+	"NavTimegps":            func() proto.Message { return &sensordata.NavTimegps{} },
+	"NavVelecef":            func() proto.Message { return &sensordata.NavVelecef{} },
+	"NavStatus":             func() proto.Message { return &sensordata.NavStatus{} },
+	"NavSig":                func() proto.Message { return &sensordata.NavSig{} },
+	"MonRf":                 func() proto.Message { return &sensordata.MonRf{} },
+	"RxmMeasx":              func() proto.Message { return &sensordata.RxmMeasx{} },
+	"RxmRawx":               func() proto.Message { return &sensordata.RxmRawx{} },
+	"RxmSfrbx":              func() proto.Message { return &sensordata.RxmSfrbx{} },
+	"TimTp":                 func() proto.Message { return &sensordata.TimTp{} },
+	"GnssEpoch":             func() proto.Message { return &sensordata.GnssEpoch{} },
+}
+
+// Keys returns every key written by the data logger, without prefix
+func Keys() []string {
+	keys := make([]string, 0, len(messageTypes))
+	for key := range messageTypes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// NewMessage returns an empty message of the type stored under key
+func NewMessage(key string) (proto.Message, error) {
+	newMessage, found := messageTypes[key]
+	if !found {
+		return nil, fmt.Errorf("unknown key %q", key)
+	}
+	return newMessage(), nil
+}
+
+// Decode decodes an entry of key, written either as binary proto or as
+// pbtxt (--redis-log-pbtxt).
+func Decode(key string, data []byte) (proto.Message, error) {
+	msg, err := NewMessage(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := DecodeInto(data, msg); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", key, err)
+	}
+	return msg, nil
+}
+
+// DecodeInto detects whether data is pbtxt or binary proto and unmarshals it
+// into msg.
+func DecodeInto(data []byte, msg proto.Message) error {
+	if looksLikeText(data) {
+		textErr := prototext.Unmarshal(data, msg)
+		if textErr == nil {
+			return nil
+		}
+		// a binary message can happen to start with a printable tag
+		proto.Reset(msg)
+		if err := proto.Unmarshal(data, msg); err != nil {
+			return fmt.Errorf("neither pbtxt (%s) nor binary proto (%s)", textErr, err)
+		}
+		return nil
+	}
+
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return fmt.Errorf("unmarshalling binary proto: %w", err)
+	}
+	return nil
+}
+
+// looksLikeText is true for pbtxt which always starts with a field name,
+// binary proto starts with a field tag and is rarely valid utf8.
+func looksLikeText(data []byte) bool {
+	if len(data) == 0 || !utf8.Valid(data) {
+		return false
+	}
+	for _, c := range data {
+		switch {
+		case c == ' ' || c == '\n' || c == '\t' || c == '\r':
+			continue
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_', c == '[':
+			return true
+		default:
+			return false
+		}
+	}
+	return false
+}
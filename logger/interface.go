@@ -1,7 +1,7 @@
 package logger
 
 type Sqlable interface {
-	InsertQuery() (query string, fields string, values []any)
+	InsertQuery() (query string, fields string, values []any, err error)
 	BufferSize() int
 }
 
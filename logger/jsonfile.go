@@ -1,8 +1,16 @@
 package logger
 
 import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/Hivemapper/gnss-controller/device/neom9n"
 	"github.com/Hivemapper/hivemapper-data-logger/data/imu"
 	"github.com/streamingfast/imu-controller/device/iim42652"
 )
@@ -64,3 +72,89 @@ func NewImuDataWrapper(temperature iim42652.Temperature, acceleration *imu.Accel
 		Fsync: NewFsync(fsync.TimeDelta, fsync.FsyncInt),
 	}
 }
+
+type jsonFileEntry struct {
+	Type       string      `json:"type"`
+	SystemTime time.Time   `json:"system_time"`
+	Data       interface{} `json:"data"`
+}
+
+// JsonFile writes every record as a flat json line to a single file.
+type JsonFile struct {
+	lock   sync.Mutex
+	path   string
+	file   *os.File
+	writer *bufio.Writer
+}
+
+func NewJsonFile(path string) *JsonFile {
+	return &JsonFile{
+		path: path,
+	}
+}
+
+func (j *JsonFile) Init() error {
+	fmt.Printf("Opening file %s for json logging\n", j.path)
+	file, err := os.OpenFile(j.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("opening json log file: %w", err)
+	}
+	j.file = file
+	j.writer = bufio.NewWriter(file)
+	return nil
+}
+
+func (j *JsonFile) LogImu(record *ImuRecord) error {
+	return j.write("ImuData", record.SystemTime, NewImuDataWrapper(record.Temperature, record.Acceleration, record.AngularRate, record.Fsync))
+}
+
+func (j *JsonFile) LogMagnetometer(record *MagnetometerRecord) error {
+	return j.write("MagnetometerData", record.SystemTime, NewMagnetometerRedisWrapper(record.SystemTime, record.X, record.Y, record.Z))
+}
+
+func (j *JsonFile) HandleUbxMessage(msg interface{}) error {
+	return j.write(UbxMessageName(msg), time.Now().UTC(), msg)
+}
+
+func (j *JsonFile) LogGnssAuth(data *neom9n.Data) error {
+	return j.write("GnssAuthData", data.SystemTime, data)
+}
+
+func (j *JsonFile) Close() error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	if err := j.writer.Flush(); err != nil {
+		return fmt.Errorf("flushing json log file: %w", err)
+	}
+	return j.file.Close()
+}
+
+func (j *JsonFile) write(entryType string, systemTime time.Time, data interface{}) error {
+	line, err := json.Marshal(&jsonFileEntry{
+		Type:       entryType,
+		SystemTime: systemTime,
+		Data:       data,
+	})
+	if err != nil {
+		return fmt.Errorf("marshalling %s: %w", entryType, err)
+	}
+
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	if _, err := j.writer.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("writing %s to json log file: %w", entryType, err)
+	}
+	return nil
+}
+
+// UbxMessageName turns *ubx.NavPvt into NavPvt, matching the redis keys.
+func UbxMessageName(msg interface{}) string {
+	t := reflect.TypeOf(msg)
+	if t == nil {
+		return "Unknown"
+	}
+	name := t.String()
+	return name[strings.LastIndex(name, ".")+1:]
+}
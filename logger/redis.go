@@ -2,12 +2,18 @@ package logger
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"net"
 	"os"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/Hivemapper/gnss-controller/device/neom9n"
+	"github.com/Hivemapper/gnss-controller/message"
 	"github.com/Hivemapper/hivemapper-data-logger/data/imu"
 	sensordata "github.com/Hivemapper/hivemapper-data-logger/proto-out"
 	"github.com/daedaleanai/ublox/ubx"
@@ -68,6 +74,55 @@ func NewMagnetometerRedisWrapper(system_time time.Time, mag_x float64, mag_y flo
 	}
 }
 
+const DefaultRedisAddress = "localhost:6379"
+
+type RedisOption func(*Redis)
+
+// WithRedisAddress sets the server address, either host:port or a unix
+// socket given as unix:///path/to/redis.sock or an absolute path.
+func WithRedisAddress(address string) RedisOption {
+	return func(s *Redis) {
+		s.address = address
+	}
+}
+
+func WithRedisPassword(password string) RedisOption {
+	return func(s *Redis) {
+		s.password = password
+	}
+}
+
+func WithRedisDB(db int) RedisOption {
+	return func(s *Redis) {
+		s.db = db
+	}
+}
+
+// WithRedisTLS enables TLS, the server certificate is verified against the
+// system pool or against caFile when it is not empty.
+func WithRedisTLS(caFile string) RedisOption {
+	return func(s *Redis) {
+		s.tlsEnabled = true
+		s.tlsCaFile = caFile
+	}
+}
+
+// WithRedisKeyPrefix namespaces every key written by the logger, a prefix of
+// "bench1:" turns "NavPvt" into "bench1:NavPvt".
+func WithRedisKeyPrefix(prefix string) RedisOption {
+	return func(s *Redis) {
+		s.keyPrefix = prefix
+	}
+}
+
+// WithRedisGnssEpochs also writes the navigation messages of every gnss
+// epoch as one GnssEpoch entry, complete when every expected message arrived.
+func WithRedisGnssEpochs(expected neom9n.EpochFlags) RedisOption {
+	return func(s *Redis) {
+		s.gnssEpochs = neom9n.NewEpochAssembler(s.LogGnssEpoch, neom9n.WithExpectedMessages(expected))
+	}
+}
+
 type Redis struct {
 	DB                 *redis.Client
 	ctx                context.Context
@@ -78,24 +133,68 @@ type Redis struct {
 	logProtoText       bool
 	gnssFilePath       string
 	gnssFileHandle     *os.File
+	gnssReplayFile     *ReplayFileWriter
+	gnssAuthCount      int
+	gnssEpochs         *neom9n.EpochAssembler
+
+	address    string
+	password   string
+	db         int
+	tlsEnabled bool
+	tlsCaFile  string
+	keyPrefix  string
+
+	streams         bool
+	streamRetention time.Duration
+	streamIDs       *streamIDs
+
+	spool     *Spool
+	spoolLock sync.Mutex
+	spooling  bool
+	done      chan struct{}
+
+	batchSize     int
+	batchInterval time.Duration
+	batchLock     sync.Mutex
+	imuBatch      *sensordata.ImuDataBatch
+	imuBatchTime  time.Time
+	magBatch      *sensordata.MagnetometerDataBatch
+	magBatchTime  time.Time
+	batches       chan *pendingBatch
+	batchesDone   chan struct{}
 }
 
-func NewRedis(maxImuEntries int, maxMagEntries int, maxGnssEntries int, maxGnssAuthEntries int, logProtoText bool, gnssFilePath string) *Redis {
-	return &Redis{
+func NewRedis(maxImuEntries int, maxMagEntries int, maxGnssEntries int, maxGnssAuthEntries int, logProtoText bool, gnssFilePath string, opts ...RedisOption) *Redis {
+	s := &Redis{
 		maxImuEntries:      maxImuEntries,
 		maxMagEntries:      maxMagEntries,
 		maxGnssEntries:     maxGnssEntries,
 		maxGnssAuthEntries: maxGnssAuthEntries,
 		logProtoText:       logProtoText,
 		gnssFilePath:       gnssFilePath,
+		address:            DefaultRedisAddress,
+		streamIDs:          newStreamIDs(),
+		done:               make(chan struct{}),
+		batches:            make(chan *pendingBatch, redisBatchQueueSize),
+		batchesDone:        make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(s)
 	}
+
+	return s
 }
 
 func (s *Redis) Init() error {
 	if len(s.gnssFilePath) != 0 {
 		fmt.Printf("Opening file %s for logging\n", s.gnssFilePath)
 		var err error
-		s.gnssFileHandle, err = os.Create(s.gnssFilePath)
+		if IsJsonReplayFile(s.gnssFilePath) {
+			s.gnssFileHandle, err = os.Create(s.gnssFilePath)
+		} else {
+			s.gnssReplayFile, err = NewReplayFileWriter(s.gnssFilePath)
+		}
 		if err != nil {
 			return fmt.Errorf("opening file: %w", err)
 		}
@@ -103,23 +202,125 @@ func (s *Redis) Init() error {
 	}
 
 	fmt.Println("Initializing Redis logger")
-	s.DB = redis.NewClient(&redis.Options{
-		Addr: "localhost:6379", // Use your Redis server address
-	})
+	options, err := s.clientOptions()
+	if err != nil {
+		return fmt.Errorf("building redis client options: %w", err)
+	}
+	s.DB = redis.NewClient(options)
 	fmt.Println("Getting context")
 	s.ctx = context.Background()
 
+	if s.spool != nil {
+		if err := s.initSpool(); err != nil {
+			return fmt.Errorf("initializing redis spool: %w", err)
+		}
+	}
+
 	// Test the connection with a PING command
 	pong, err := s.DB.Ping(s.ctx).Result()
 	if err != nil {
-		fmt.Printf("Could not connect to Redis: %v\n", err)
-		return fmt.Errorf("ping pong failed")
+		fmt.Printf("Could not connect to Redis at %s: %v\n", s.address, err)
+		if s.spool == nil {
+			return fmt.Errorf("ping pong failed")
+		}
+		fmt.Println("Spooling redis records until redis is reachable")
+		s.setSpooling(true)
+	} else {
+		fmt.Println("Redis connected:", pong)
+	}
+
+	if s.batching() {
+		go s.runBatches()
 	}
-	fmt.Println("Redis connected:", pong)
 	fmt.Println("Redis logger initialized")
 	return nil
 }
 
+func (s *Redis) clientOptions() (*redis.Options, error) {
+	network, address := redisNetworkAddress(s.address)
+	options := &redis.Options{
+		Network:  network,
+		Addr:     address,
+		Password: s.password,
+		DB:       s.db,
+	}
+
+	if s.tlsEnabled {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+		if s.tlsCaFile != "" {
+			caCert, err := os.ReadFile(s.tlsCaFile)
+			if err != nil {
+				return nil, fmt.Errorf("reading ca file: %w", err)
+			}
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+				return nil, fmt.Errorf("no certificate found in %s", s.tlsCaFile)
+			}
+		}
+		if host, _, err := net.SplitHostPort(address); err == nil && network == "tcp" {
+			tlsConfig.ServerName = host
+		}
+		options.TLSConfig = tlsConfig
+	}
+
+	return options, nil
+}
+
+func redisNetworkAddress(address string) (string, string) {
+	if strings.HasPrefix(address, "unix://") {
+		return "unix", strings.TrimPrefix(address, "unix://")
+	}
+	if strings.HasPrefix(address, "/") {
+		return "unix", address
+	}
+	return "tcp", address
+}
+
+// key returns the namespaced redis key
+func (s *Redis) key(name string) string {
+	return s.keyPrefix + name
+}
+
+func (s *Redis) LogImu(record *ImuRecord) error {
+	return s.LogImuData(*NewImuRedisWrapper(record.SystemTime, record.Temperature, record.Acceleration, record.AngularRate, record.Fsync))
+}
+
+func (s *Redis) LogMagnetometer(record *MagnetometerRecord) error {
+	return s.LogMagnetometerData(*NewMagnetometerRedisWrapper(record.SystemTime, record.X, record.Y, record.Z))
+}
+
+// LogGnssAuth only keeps one signature out of 60 in redis.
+func (s *Redis) LogGnssAuth(data *neom9n.Data) error {
+	defer func() { s.gnssAuthCount++ }()
+	if s.gnssAuthCount%60 != 0 {
+		return nil
+	}
+	return s.LogGnssAuthData(*data)
+}
+
+func (s *Redis) Close() error {
+	close(s.done)
+	if s.batching() {
+		<-s.batchesDone
+	}
+	if s.spool != nil {
+		if err := s.spool.Close(); err != nil {
+			return fmt.Errorf("closing redis spool: %w", err)
+		}
+	}
+	if s.gnssFileHandle != nil {
+		if err := s.gnssFileHandle.Close(); err != nil {
+			return fmt.Errorf("closing gnss file: %w", err)
+		}
+	}
+	if s.gnssReplayFile != nil {
+		if err := s.gnssReplayFile.Close(); err != nil {
+			return err
+		}
+	}
+	return s.DB.Close()
+}
+
 func (s *Redis) LogImuData(imudata ImuRedisWrapper) error {
 	// create imu proto
 	newdata := sensordata.ImuData{
@@ -141,20 +342,17 @@ func (s *Redis) LogImuData(imudata ImuRedisWrapper) error {
 			TimeDelta: int32(imudata.Fsync.TimeDelta),
 		},
 	}
+	if s.batching() {
+		return s.addImuSample(&newdata, imudata.Time)
+	}
+
 	// serialize the data
 	protodata, err := s.Marshal(&newdata)
 	if err != nil {
 		return err
 	}
 
-	pipe := s.DB.Pipeline()
-	pipe.LPush(s.ctx, "ImuData", protodata)
-	pipe.LTrim(s.ctx, "ImuData", 0, int64(s.maxImuEntries))
-	_, err = pipe.Exec(s.ctx)
-	if err != nil {
-		return err
-	}
-	return nil
+	return s.push("ImuData", imudata.Time, s.maxImuEntries, protodata)
 }
 
 func (s *Redis) LogMagnetometerData(magdata MagnetometerRedisWrapper) error {
@@ -165,20 +363,23 @@ func (s *Redis) LogMagnetometerData(magdata MagnetometerRedisWrapper) error {
 		Y:          magdata.Mag_y,
 		Z:          magdata.Mag_z,
 	}
+	if s.batching() {
+		return s.addMagnetometerSample(&newdata, magdata.System_time)
+	}
+
 	// serialize the data
 	protodata, err := s.Marshal(&newdata)
 	if err != nil {
 		return err
 	}
 
-	// Push the JSON data to the Redis list
-	if err := s.DB.LPush(s.ctx, "MagnetometerData", protodata).Err(); err != nil {
-		return err
-	}
-	if err := s.DB.LTrim(s.ctx, "MagnetometerData", 0, int64(s.maxMagEntries)).Err(); err != nil {
-		return err
-	}
-	return nil
+	return s.push("MagnetometerData", magdata.System_time, s.maxMagEntries, protodata)
+}
+
+var authStatuses = map[message.AuthStatus]sensordata.GnssData_AuthStatus{
+	message.AuthUnknown:  sensordata.GnssData_AUTH_STATUS_UNKNOWN,
+	message.AuthVerified: sensordata.GnssData_AUTH_STATUS_VERIFIED,
+	message.AuthFailed:   sensordata.GnssData_AUTH_STATUS_FAILED,
 }
 
 func (s *Redis) LogGnssAuthData(gnssAuthData neom9n.Data) error {
@@ -193,20 +394,14 @@ func (s *Redis) LogGnssAuthData(gnssAuthData neom9n.Data) error {
 			EcdsaSignature: gnssAuthData.SecEcsign.EcdsaSignature[:],
 		},
 		SecEcsignBuffer: gnssAuthData.SecEcsignBuffer,
+		AuthStatus:      authStatuses[gnssAuthData.AuthStatus],
 	}
 	protodata, err := s.Marshal(&newdata)
 	if err != nil {
 		return err
 	}
 
-	// Push the JSON data to the Redis list
-	if err := s.DB.LPush(s.ctx, "GnssAuthData", protodata).Err(); err != nil {
-		return err
-	}
-	if err := s.DB.LTrim(s.ctx, "GnssAuthData", 0, int64(s.maxGnssAuthEntries)).Err(); err != nil {
-		return err
-	}
-	return nil
+	return s.push("GnssAuthData", gnssAuthData.SystemTime, s.maxGnssAuthEntries, protodata)
 }
 
 func (s *Redis) Marshal(message proto.Message) ([]byte, error) {
@@ -244,159 +439,62 @@ func (s *Redis) HandleUbxMessage(msg interface{}) error {
 		return nil
 	}
 
+	if s.gnssEpochs != nil {
+		if err := s.gnssEpochs.HandleUbxMessage(msg); err != nil {
+			return err
+		}
+	}
+
 	switch m := msg.(type) {
 	case *ubx.NavPvt:
 		redisKey = "NavPvt"
-		// serialize as proto
-		protomessage := sensordata.NavPvt{
-			SystemTime:   systemTime.String(),
-			UptimeMs:     upTime,
-			ItowMs:       m.ITOW_ms,
-			YearY:        uint32(m.Year_y),
-			MonthMonth:   uint32(m.Month_month),
-			DayD:         uint32(m.Day_d),
-			HourH:        uint32(m.Hour_h),
-			MinMin:       uint32(m.Min_min),
-			SecS:         uint32(m.Sec_s),
-			Valid:        uint32(m.Valid),
-			TAccNs:       uint32(m.TAcc_ns),
-			NanoNs:       uint32(m.Nano_ns),
-			FixType:      uint32(m.FixType),
-			Flags:        uint32(m.Flags),
-			Flags2:       uint32(m.Flags2),
-			NumSv:        uint32(m.NumSV),
-			LonDege7:     int32(m.Lon_dege7),
-			LatDege7:     int32(m.Lat_dege7),
-			HeightMm:     int32(m.Height_mm),
-			HmslMm:       int32(m.HMSL_mm),
-			HAccMm:       uint32(m.HAcc_mm),
-			VAccMm:       uint32(m.VAcc_mm),
-			VelNMmS:      int32(m.VelN_mm_s),
-			VelEMmS:      int32(m.VelE_mm_s),
-			VelDMmS:      int32(m.VelD_mm_s),
-			GSpeedMmS:    int32(m.GSpeed_mm_s),
-			HeadMotDege5: int32(m.HeadMot_dege5),
-			SAccMmS:      uint32(m.SAcc_mm_s),
-			HeadAccDege5: int32(m.HeadAcc_dege5),
-			Pdop:         uint32(m.PDOP),
-			Flags3:       uint32(m.Flags3),
-			HeadVehDege5: int32(m.HeadVeh_dege5),
-			MagDecDege2:  int32(m.MagDec_dege2),
-			MagAccDege2:  uint32(m.MagAcc_dege2),
-		}
 		if prevItowMs["NavPvt"] != 0 && m.ITOW_ms-prevItowMs["NavPvt"] > NavGapLimit {
 			fmt.Println(time.Now().UTC(), "[WARNING] NavPvt drop of", m.ITOW_ms-prevItowMs["NavPvt"], "ms (", prevItowMs["NavPvt"], ",", m.ITOW_ms, ")")
 		}
 		prevItowMs["NavPvt"] = m.ITOW_ms
-		protodata, err = s.Marshal(&protomessage)
+		protodata, err = s.Marshal(navPvtProto(m, systemTime, upTime))
 	case *ubx.NavDop:
 		redisKey = "NavDop"
-		// serialize as proto
-		protomessage := sensordata.NavDop{
-			SystemTime: systemTime.String(),
-			ItowMs:     m.ITOW_ms,
-			Gdop:       uint32(m.GDOP),
-			Pdop:       uint32(m.PDOP),
-			Tdop:       uint32(m.TDOP),
-			Vdop:       uint32(m.VDOP),
-			Hdop:       uint32(m.HDOP),
-			Ndop:       uint32(m.NDOP),
-			Edop:       uint32(m.EDOP),
-		}
 		if prevItowMs["NavDop"] != 0 && m.ITOW_ms-prevItowMs["NavDop"] > NavGapLimit {
 			fmt.Println(time.Now().UTC(), "[WARNING] NavDop drop of", m.ITOW_ms-prevItowMs["NavDop"], "ms (", prevItowMs["NavDop"], ",", m.ITOW_ms, ")")
 		}
 		prevItowMs["NavDop"] = m.ITOW_ms
-		protodata, err = s.Marshal(&protomessage)
+		protodata, err = s.Marshal(navDopProto(m, systemTime))
 	case *ubx.NavCov:
 		redisKey = "NavCov"
-		// serialize as proto
-		protomessage := sensordata.NavCov{
-			ItowMs:      m.ITOW_ms,
-			Version:     uint32(m.Version),
-			PosCovValid: uint32(m.PosCovValid),
-			VelCovValid: uint32(m.VelCovValid),
-			PosCovNN:    float64(m.PosCovNN_m2),
-			PosCovNE:    float64(m.PosCovNE_m2),
-			PosCovND:    float64(m.PosCovND_m2),
-			PosCovEE:    float64(m.PosCovEE_m2),
-			PosCovED:    float64(m.PosCovED_m2),
-			PosCovDD:    float64(m.PosCovDD_m2),
-			VelCovNN:    float64(m.VelCovNN_m2_s2),
-			VelCovNE:    float64(m.VelCovNE_m2_s2),
-			VelCovND:    float64(m.VelCovND_m2_s2),
-			VelCovEE:    float64(m.VelCovEE_m2_s2),
-			VelCovED:    float64(m.VelCovED_m2_s2),
-			VelCovDD:    float64(m.VelCovDD_m2_s2),
-		}
 		if prevItowMs["NavCov"] != 0 && m.ITOW_ms-prevItowMs["NavCov"] > NavGapLimit {
 			fmt.Println(time.Now().UTC(), "[WARNING] NavCov drop of", m.ITOW_ms-prevItowMs["NavCov"], "ms (", prevItowMs["NavCov"], ",", m.ITOW_ms, ")")
 		}
 		prevItowMs["NavCov"] = m.ITOW_ms
-		protodata, err = s.Marshal(&protomessage)
+		protodata, err = s.Marshal(navCovProto(m))
 	case *ubx.NavPosecef:
 		redisKey = "NavPosecef"
-		// serialize as proto
-		protomessage := sensordata.NavPosecef{
-			ItowMs:  m.ITOW_ms,
-			EcefXCm: int32(m.EcefX_cm),
-			EcefYCm: int32(m.EcefY_cm),
-			EcefZCm: int32(m.EcefZ_cm),
-			PAccCm:  uint32(m.PAcc_cm),
-		}
 		if prevItowMs["NavPosecef"] != 0 && m.ITOW_ms-prevItowMs["NavPosecef"] > NavGapLimit {
 			fmt.Println(time.Now().UTC(), "[WARNING] NavPosecef drop of", m.ITOW_ms-prevItowMs["NavPosecef"], "ms (", prevItowMs["NavPosecef"], ",", m.ITOW_ms, ")")
 		}
 		prevItowMs["NavPosecef"] = m.ITOW_ms
-		protodata, err = s.Marshal(&protomessage)
+		protodata, err = s.Marshal(navPosecefProto(m))
 	case *ubx.NavTimegps:
 		redisKey = "NavTimegps"
-		// serialize as proto
-		protomessage := sensordata.NavTimegps{
-			ItowMs: uint32(m.ITOW_ms),
-			FtowNs: int32(m.FTOW_ns),
-			Week:   int32(m.Week),
-			LeapS:  int32(m.LeapS_s),
-			Valid:  uint32(m.Valid),
-			TAccNs: uint32(m.TAcc_ns),
-		}
 		if prevItowMs["NavTimegps"] != 0 && m.ITOW_ms-prevItowMs["NavTimegps"] > NavGapLimit {
 			fmt.Println(time.Now().UTC(), "[WARNING] NavTimegps drop of", m.ITOW_ms-prevItowMs["NavTimegps"], "ms (", prevItowMs["NavTimegps"], ",", m.ITOW_ms, ")")
 		}
 		prevItowMs["NavTimegps"] = m.ITOW_ms
-		protodata, err = s.Marshal(&protomessage)
+		protodata, err = s.Marshal(navTimegpsProto(m))
 	case *ubx.NavVelecef:
 		redisKey = "NavVelecef"
-		// serialize as proto
-		protomessage := sensordata.NavVelecef{
-			ItowMs:    uint32(m.ITOW_ms),
-			EcefVxCmS: int32(m.EcefVX_cm_s),
-			EcefVyCmS: int32(m.EcefVY_cm_s),
-			EcefVzCmS: int32(m.EcefVZ_cm_s),
-			SAccCmS:   uint32(m.SAcc_cm_s),
-		}
 		if prevItowMs["NavVelecef"] != 0 && m.ITOW_ms-prevItowMs["NavVelecef"] > NavGapLimit {
 			fmt.Println(time.Now().UTC(), "[WARNING] NavVelecef drop of", m.ITOW_ms-prevItowMs["NavVelecef"], "ms (", prevItowMs["NavVelecef"], ",", m.ITOW_ms, ")")
 		}
 		prevItowMs["NavVelecef"] = m.ITOW_ms
-		protodata, err = s.Marshal(&protomessage)
+		protodata, err = s.Marshal(navVelecefProto(m))
 	case *ubx.NavStatus:
 		redisKey = "NavStatus"
-		// serialize as proto
-		protomessage := sensordata.NavStatus{
-			ItowMs:  uint32(m.ITOW_ms),
-			GpsFix:  uint32(m.GpsFix),
-			Flags:   uint32(m.Flags),
-			FixStat: uint32(m.FixStat),
-			Flags2:  uint32(m.Flags2),
-			Ttff:    uint32(m.Ttff_ms),
-			Msss:    uint32(m.Msss_ms),
-		}
 		if prevItowMs["NavStatus"] != 0 && m.ITOW_ms-prevItowMs["NavStatus"] > NavGapLimit {
 			fmt.Println(time.Now().UTC(), "[WARNING] NavStatus drop of", m.ITOW_ms-prevItowMs["NavStatus"], "ms (", prevItowMs["NavStatus"], ",", m.ITOW_ms, ")")
 		}
 		prevItowMs["NavStatus"] = m.ITOW_ms
-		protodata, err = s.Marshal(&protomessage)
+		protodata, err = s.Marshal(navStatusProto(m))
 	// case *ubx.NavSat:
 	// 	redisKey = "NavSat"
 	// 	protomessage := sensordata.NavSat{
@@ -424,32 +522,14 @@ func (s *Redis) HandleUbxMessage(msg interface{}) error {
 	// 	protodata, err = s.Marshal(&protomessage)
 	case *ubx.NavSig:
 		redisKey = "NavSig"
-		protomessage := sensordata.NavSig{
-			SystemTime: systemTime.String(),
-			ItowMs:     m.ITOW_ms,
-			Version:    uint32(m.Version),
-			NumSigs:    uint32(m.NumSigs),
-		}
-		protomessage.Sigs = make([]*sensordata.NavSig_Sigs, len(m.Sigs))
-		for i, sig := range m.Sigs {
-			protomessage.Sigs[i] = &sensordata.NavSig_Sigs{
-				GnssId:     uint32(sig.GnssId),
-				SvId:       uint32(sig.SvId),
-				SigId:      uint32(sig.SigId),
-				FreqId:     uint32(sig.FreqId),
-				PrResMe1:   int32(sig.PrRes_me1),
-				CnoDbhz:    uint32(sig.Cno_dbhz),
-				QualityInd: uint32(sig.QualityInd),
-				CorrSource: uint32(sig.CorrSource),
-				IonoModel:  uint32(sig.IonoModel),
-				SigFlags:   uint32(sig.SigFlags),
-			}
-		}
 		if prevItowMs["NavSig"] != 0 && m.ITOW_ms-prevItowMs["NavSig"] > NavGapLimit {
 			fmt.Println(time.Now().UTC(), "[WARNING] NavSig drop of", m.ITOW_ms-prevItowMs["NavSig"], "ms (", prevItowMs["NavSig"], ",", m.ITOW_ms, ")")
 		}
 		prevItowMs["NavSig"] = m.ITOW_ms
-		protodata, err = s.Marshal(&protomessage)
+		protodata, err = s.Marshal(navSigProto(m, systemTime))
+	case *ubx.NavEoe:
+		// only closes the epoch
+		return nil
 	case *ubx.MonRf:
 		redisKey = "MonRf"
 		protomessage := sensordata.MonRf{
@@ -585,17 +665,30 @@ func (s *Redis) HandleUbxMessage(msg interface{}) error {
 		return err
 	}
 
-	if s.gnssFileHandle == nil {
-		// Push the proto data to the Redis list
-		if err := s.DB.LPush(s.ctx, redisKey, protodata).Err(); err != nil {
-			return err
-		}
+	return s.writeGnss(redisKey, systemTime, protodata)
+}
+
+// LogGnssEpoch writes the messages of the epoch as one GnssEpoch entry
+func (s *Redis) LogGnssEpoch(epoch *neom9n.Epoch) error {
+	protodata, err := s.Marshal(gnssEpochProto(epoch))
+	if err != nil {
+		return err
+	}
+	return s.writeGnss("GnssEpoch", epoch.SystemTime, protodata)
+}
 
-		// Trim the list to the max number of entries
-		if err := s.DB.LTrim(s.ctx, redisKey, 0, int64(s.maxGnssEntries)).Err(); err != nil {
+// writeGnss writes a gnss entry to the replay file or to redis
+func (s *Redis) writeGnss(redisKey string, systemTime time.Time, protodata []byte) error {
+	switch {
+	case s.gnssReplayFile != nil:
+		if err := s.gnssReplayFile.Write(redisKey, systemTime, protodata); err != nil {
+			return fmt.Errorf("failed to write gnss data to file: %w", err)
+		}
+	case s.gnssFileHandle == nil:
+		if err := s.push(redisKey, systemTime, s.maxGnssEntries, protodata); err != nil {
 			return err
 		}
-	} else {
+	default:
 		// This should only be used with pbtxt
 
 		// serialize into json with two fields "redisKey" and "data"
@@ -619,17 +712,7 @@ func (s *Redis) HandleUbxMessage(msg interface{}) error {
 }
 
 func (s *Redis) LogGnssReplayData(redisKey string, data []byte) error {
-	// Push the proto data to the Redis list
-	if err := s.DB.LPush(s.ctx, redisKey, data).Err(); err != nil {
-		return err
-	}
-
-	// Trim the list to the max number of entries
-	if err := s.DB.LTrim(s.ctx, redisKey, 0, int64(s.maxGnssEntries)).Err(); err != nil {
-		return err
-	}
-
-	return nil
+	return s.push(redisKey, time.Now().UTC(), s.maxGnssEntries, data)
 }
 
 type GnssReplayEvent struct {
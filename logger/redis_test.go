@@ -0,0 +1,150 @@
+package logger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/device/neom9n"
+	sensordata "github.com/Hivemapper/hivemapper-data-logger/proto-out"
+	"github.com/daedaleanai/ublox/ubx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RedisClientOptions(t *testing.T) {
+	tests := []struct {
+		name            string
+		opts            []RedisOption
+		expectedNetwork string
+		expectedAddr    string
+		expectedDB      int
+		expectedTLS     bool
+	}{
+		{
+			name:            "default",
+			expectedNetwork: "tcp",
+			expectedAddr:    "localhost:6379",
+		},
+		{
+			name:            "unix socket url",
+			opts:            []RedisOption{WithRedisAddress("unix:///var/run/redis/redis.sock"), WithRedisDB(2)},
+			expectedNetwork: "unix",
+			expectedAddr:    "/var/run/redis/redis.sock",
+			expectedDB:      2,
+		},
+		{
+			name:            "unix socket path",
+			opts:            []RedisOption{WithRedisAddress("/tmp/redis.sock")},
+			expectedNetwork: "unix",
+			expectedAddr:    "/tmp/redis.sock",
+		},
+		{
+			name:            "tls",
+			opts:            []RedisOption{WithRedisAddress("bench.local:6380"), WithRedisTLS("")},
+			expectedNetwork: "tcp",
+			expectedAddr:    "bench.local:6380",
+			expectedTLS:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			options, err := NewRedis(1, 1, 1, 1, false, "", test.opts...).clientOptions()
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedNetwork, options.Network)
+			assert.Equal(t, test.expectedAddr, options.Addr)
+			assert.Equal(t, test.expectedDB, options.DB)
+			assert.Equal(t, test.expectedTLS, options.TLSConfig != nil)
+			if test.expectedTLS {
+				assert.Equal(t, "bench.local", options.TLSConfig.ServerName)
+			}
+		})
+	}
+}
+
+func Test_RedisKeyPrefix(t *testing.T) {
+	assert.Equal(t, "NavPvt", NewRedis(1, 1, 1, 1, false, "").key("NavPvt"))
+	assert.Equal(t, "bench1:NavPvt", NewRedis(1, 1, 1, 1, false, "", WithRedisKeyPrefix("bench1:")).key("NavPvt"))
+}
+
+func Test_StreamIDs(t *testing.T) {
+	ids := newStreamIDs()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "1704067200000-0", ids.next("ImuData", start).String())
+	// same millisecond
+	assert.Equal(t, "1704067200000-1", ids.next("ImuData", start.Add(500*time.Microsecond)).String())
+	assert.Equal(t, "1704067200005-0", ids.next("ImuData", start.Add(5*time.Millisecond)).String())
+	// clock went back
+	assert.Equal(t, "1704067200005-1", ids.next("ImuData", start.Add(-time.Second)).String())
+	// every key has its own sequence
+	assert.Equal(t, "1704067200000-0", ids.next("NavPvt", start).String())
+}
+
+func Test_StreamIDsSeed(t *testing.T) {
+	ids := newStreamIDs()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// the stream top written by a previous run
+	top, err := parseStreamID("1704067200010-3")
+	require.NoError(t, err)
+	ids.seed("ImuData", top)
+	assert.Equal(t, "1704067200010-4", ids.next("ImuData", start).String())
+	assert.Equal(t, "1704067200020-0", ids.next("ImuData", start.Add(20*time.Millisecond)).String())
+
+	ids.seed("ImuData", top)
+	assert.Equal(t, "1704067200020-1", ids.next("ImuData", start).String(), "an older top doesn't go back")
+
+	_, err = parseStreamID("top")
+	assert.Error(t, err)
+}
+
+func Test_RedisImuBatching(t *testing.T) {
+	s := NewRedis(1000, 1000, 1000, 1000, false, "", WithRedisBatching(3, time.Second))
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 7; i++ {
+		require.NoError(t, s.addImuSample(&sensordata.ImuData{Temperature: float64(i)}, start.Add(time.Duration(i)*5*time.Millisecond)))
+	}
+	require.NoError(t, s.addMagnetometerSample(&sensordata.MagnetometerData{X: 1}, start))
+
+	require.Len(t, s.batches, 2)
+	first := <-s.batches
+	assert.Equal(t, "ImuDataBatch", first.key)
+	assert.Equal(t, start, first.sensorTime)
+	assert.Equal(t, 333, first.maxEntries)
+	samples := first.message.(*sensordata.ImuDataBatch).Samples
+	require.Len(t, samples, 3)
+	assert.Equal(t, 0.0, samples[0].Temperature)
+	assert.Equal(t, 2.0, samples[2].Temperature)
+
+	second := <-s.batches
+	assert.Equal(t, start.Add(15*time.Millisecond), second.sensorTime)
+
+	// partial batches are only flushed once their oldest sample is older than the interval
+	assert.Empty(t, s.takeExpiredBatches(start.Add(500*time.Millisecond), false))
+	expired := s.takeExpiredBatches(start.Add(1100*time.Millisecond), false)
+	require.Len(t, expired, 2)
+	assert.Len(t, expired[0].message.(*sensordata.ImuDataBatch).Samples, 1)
+	assert.Equal(t, "MagnetometerDataBatch", expired[1].key)
+	assert.Empty(t, s.takeExpiredBatches(start.Add(time.Hour), true))
+}
+
+func Test_GnssEpochProto(t *testing.T) {
+	epoch := &neom9n.Epoch{
+		ITOW:       1000,
+		SystemTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		NavPvt:     &ubx.NavPvt{ITOW_ms: 1000, NumSV: 12},
+		NavDop:     &ubx.NavDop{ITOW_ms: 1000, PDOP: 120},
+		Flags:      neom9n.EpochNavPvt | neom9n.EpochNavDop | neom9n.EpochNavEoe,
+		Expected:   neom9n.EpochNavPvt | neom9n.EpochNavCov | neom9n.EpochNavEoe,
+	}
+
+	protomessage := gnssEpochProto(epoch)
+	assert.Equal(t, uint32(1000), protomessage.ItowMs)
+	assert.False(t, protomessage.Complete)
+	assert.Equal(t, uint32(neom9n.EpochNavCov), protomessage.Missing)
+	assert.Equal(t, uint32(12), protomessage.NavPvt.NumSv)
+	assert.Equal(t, uint32(120), protomessage.NavDop.Pdop)
+	assert.Nil(t, protomessage.NavCov)
+}
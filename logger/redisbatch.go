@@ -0,0 +1,176 @@
+package logger
+
+import (
+	"fmt"
+	"time"
+
+	sensordata "github.com/Hivemapper/hivemapper-data-logger/proto-out"
+	"google.golang.org/protobuf/proto"
+)
+
+const redisBatchQueueSize = 64
+
+// WithRedisBatching packs imu and magnetometer samples into ImuDataBatch and
+// MagnetometerDataBatch entries of up to size samples, written under the
+// ImuDataBatch and MagnetometerDataBatch keys. A batch is flushed when full
+// or when its oldest sample is older than interval, the writes happen in the
+// background so the sensor feeds never wait on redis.
+func WithRedisBatching(size int, interval time.Duration) RedisOption {
+	return func(s *Redis) {
+		s.batchSize = size
+		s.batchInterval = interval
+	}
+}
+
+type pendingBatch struct {
+	key        string
+	sensorTime time.Time
+	maxEntries int
+	message    proto.Message
+}
+
+func (s *Redis) batching() bool {
+	return s.batchSize > 0
+}
+
+// batchMaxEntries keeps roughly the same number of samples in redis as the
+// unbatched lists
+func (s *Redis) batchMaxEntries(maxEntries int) int {
+	if maxEntries/s.batchSize < 1 {
+		return 1
+	}
+	return maxEntries / s.batchSize
+}
+
+func (s *Redis) addImuSample(sample *sensordata.ImuData, sensorTime time.Time) error {
+	s.batchLock.Lock()
+	if s.imuBatch == nil {
+		s.imuBatch = &sensordata.ImuDataBatch{}
+		s.imuBatchTime = sensorTime
+	}
+	s.imuBatch.Samples = append(s.imuBatch.Samples, sample)
+	if len(s.imuBatch.Samples) < s.batchSize {
+		s.batchLock.Unlock()
+		return nil
+	}
+	batch := s.takeImuBatch()
+	s.batchLock.Unlock()
+
+	return s.queueBatch(batch)
+}
+
+func (s *Redis) addMagnetometerSample(sample *sensordata.MagnetometerData, sensorTime time.Time) error {
+	s.batchLock.Lock()
+	if s.magBatch == nil {
+		s.magBatch = &sensordata.MagnetometerDataBatch{}
+		s.magBatchTime = sensorTime
+	}
+	s.magBatch.Samples = append(s.magBatch.Samples, sample)
+	if len(s.magBatch.Samples) < s.batchSize {
+		s.batchLock.Unlock()
+		return nil
+	}
+	batch := s.takeMagnetometerBatch()
+	s.batchLock.Unlock()
+
+	return s.queueBatch(batch)
+}
+
+// takeImuBatch must be called with batchLock held
+func (s *Redis) takeImuBatch() *pendingBatch {
+	if s.imuBatch == nil {
+		return nil
+	}
+	batch := &pendingBatch{
+		key:        "ImuDataBatch",
+		sensorTime: s.imuBatchTime,
+		maxEntries: s.batchMaxEntries(s.maxImuEntries),
+		message:    s.imuBatch,
+	}
+	s.imuBatch = nil
+	return batch
+}
+
+// takeMagnetometerBatch must be called with batchLock held
+func (s *Redis) takeMagnetometerBatch() *pendingBatch {
+	if s.magBatch == nil {
+		return nil
+	}
+	batch := &pendingBatch{
+		key:        "MagnetometerDataBatch",
+		sensorTime: s.magBatchTime,
+		maxEntries: s.batchMaxEntries(s.maxMagEntries),
+		message:    s.magBatch,
+	}
+	s.magBatch = nil
+	return batch
+}
+
+// takeExpiredBatches returns the partial batches whose oldest sample is older
+// than the batch interval, or all of them when force is set.
+func (s *Redis) takeExpiredBatches(now time.Time, force bool) []*pendingBatch {
+	s.batchLock.Lock()
+	defer s.batchLock.Unlock()
+
+	var batches []*pendingBatch
+	if s.imuBatch != nil && (force || now.Sub(s.imuBatchTime) >= s.batchInterval) {
+		batches = append(batches, s.takeImuBatch())
+	}
+	if s.magBatch != nil && (force || now.Sub(s.magBatchTime) >= s.batchInterval) {
+		batches = append(batches, s.takeMagnetometerBatch())
+	}
+	return batches
+}
+
+func (s *Redis) queueBatch(batch *pendingBatch) error {
+	select {
+	case s.batches <- batch:
+		return nil
+	default:
+		return fmt.Errorf("redis batch queue full, dropping %s batch", batch.key)
+	}
+}
+
+func (s *Redis) runBatches() {
+	defer close(s.batchesDone)
+
+	interval := s.batchInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			// flush everything still queued along with the partial batches
+			for len(s.batches) > 0 {
+				s.writeBatch(<-s.batches)
+			}
+			for _, batch := range s.takeExpiredBatches(time.Now(), true) {
+				s.writeBatch(batch)
+			}
+			return
+		case batch := <-s.batches:
+			s.writeBatch(batch)
+		case <-ticker.C:
+			// sensor times are system times for the magnetometer and interpolated
+			// system times for the imu, both comparable to the wall clock
+			for _, batch := range s.takeExpiredBatches(time.Now().UTC(), false) {
+				s.writeBatch(batch)
+			}
+		}
+	}
+}
+
+func (s *Redis) writeBatch(batch *pendingBatch) {
+	data, err := s.Marshal(batch.message)
+	if err != nil {
+		fmt.Printf("marshalling %s: %s\n", batch.key, err)
+		return
+	}
+	if err := s.push(batch.key, batch.sensorTime, batch.maxEntries, data); err != nil {
+		fmt.Printf("writing %s: %s\n", batch.key, err)
+	}
+}
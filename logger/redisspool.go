@@ -0,0 +1,129 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const redisReconnectInterval = 5 * time.Second
+
+// WithRedisSpool keeps the records in an on-disk spool of at most maxSize
+// bytes while redis is unreachable. The spool is drained in order once redis
+// is back, the oldest records are dropped if it fills up.
+func WithRedisSpool(dir string, maxSize int64) RedisOption {
+	return func(s *Redis) {
+		s.spool = NewSpool(dir, maxSize)
+	}
+}
+
+func (s *Redis) initSpool() error {
+	if err := s.spool.Open(); err != nil {
+		return err
+	}
+	if !s.spool.Empty() {
+		fmt.Printf("Redis spool has %d bytes left over, draining it first\n", s.spool.Size())
+		s.setSpooling(true)
+	}
+	go s.reconnect()
+	return nil
+}
+
+// push writes the record to redis or, while redis is unreachable, to the
+// spool. Records keep going to the spool until it is fully drained so they
+// reach redis in order.
+func (s *Redis) push(key string, sensorTime time.Time, maxEntries int, data []byte) error {
+	if s.spool == nil {
+		return s.write(key, sensorTime, maxEntries, data)
+	}
+
+	record := &SpoolRecord{Key: key, SensorTime: sensorTime, MaxEntries: maxEntries, Data: data}
+
+	s.spoolLock.Lock()
+	if s.spooling {
+		defer s.spoolLock.Unlock()
+		return s.spool.Append(record)
+	}
+	s.spoolLock.Unlock()
+
+	err := s.write(key, sensorTime, maxEntries, data)
+	if err == nil || !isConnectionError(err) {
+		return err
+	}
+
+	fmt.Printf("Redis unreachable, spooling records: %s\n", err)
+	s.spoolLock.Lock()
+	defer s.spoolLock.Unlock()
+	s.spooling = true
+	return s.spool.Append(record)
+}
+
+func (s *Redis) setSpooling(spooling bool) {
+	s.spoolLock.Lock()
+	defer s.spoolLock.Unlock()
+	s.spooling = spooling
+}
+
+func (s *Redis) isSpooling() bool {
+	s.spoolLock.Lock()
+	defer s.spoolLock.Unlock()
+	return s.spooling
+}
+
+func (s *Redis) reconnect() {
+	ticker := time.NewTicker(redisReconnectInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+		}
+
+		if !s.isSpooling() {
+			continue
+		}
+		if err := s.DB.Ping(s.ctx).Err(); err != nil {
+			continue
+		}
+
+		fmt.Printf("Redis reachable again, draining %d spooled bytes\n", s.spool.Size())
+		if err := s.drainSpool(); err != nil {
+			fmt.Printf("draining redis spool: %s\n", err)
+			continue
+		}
+		fmt.Println("Redis spool drained")
+	}
+}
+
+func (s *Redis) drainSpool() error {
+	write := func(record *SpoolRecord) error {
+		return s.write(record.Key, record.SensorTime, record.MaxEntries, record.Data)
+	}
+
+	for {
+		if err := s.spool.Drain(write); err != nil {
+			return err
+		}
+
+		// records spooled while draining are drained by the next pass, the
+		// lock guarantees nothing gets appended once the spool is seen empty
+		s.spoolLock.Lock()
+		if s.spool.Empty() {
+			s.spooling = false
+			s.spoolLock.Unlock()
+			return nil
+		}
+		s.spoolLock.Unlock()
+	}
+}
+
+// isConnectionError is true for everything but an error reply of the redis
+// server, there is no point in spooling a command redis rejected.
+func isConnectionError(err error) bool {
+	var redisErr redis.Error
+	return !errors.As(err, &redisErr)
+}
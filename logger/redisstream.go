@@ -0,0 +1,142 @@
+package logger
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// RedisStreamDataField is the stream entry field holding the serialized record.
+const RedisStreamDataField = "data"
+
+// WithRedisStreams writes every record with XADD to a redis stream instead of
+// pushing it to a list. Entries older than retention are evicted, a zero
+// retention falls back to the max entries count of each key.
+func WithRedisStreams(retention time.Duration) RedisOption {
+	return func(s *Redis) {
+		s.streams = true
+		s.streamRetention = retention
+	}
+}
+
+// streamTopError is the XADD error for an ID at or below the last entry of
+// the stream
+const streamTopError = "equal or smaller than the target stream top item"
+
+// streamIDs hands out strictly increasing stream entry IDs derived from the
+// sensor time of the records, "<unix ms>-<sequence>". Records sharing the same
+// millisecond, or going back in time, get the next sequence of the last ID so
+// XADD never rejects them. The last ID is only known to this writer, a stream
+// written by a previous run or by another writer seeds it with its top.
+type streamIDs struct {
+	lock sync.Mutex
+	last map[string]streamID
+}
+
+type streamID struct {
+	ms  int64
+	seq int64
+}
+
+func (id streamID) String() string {
+	return fmt.Sprintf("%d-%d", id.ms, id.seq)
+}
+
+func parseStreamID(id string) (streamID, error) {
+	var parsed streamID
+	if _, err := fmt.Sscanf(id, "%d-%d", &parsed.ms, &parsed.seq); err != nil {
+		return streamID{}, fmt.Errorf("parsing stream id %q: %w", id, err)
+	}
+	return parsed, nil
+}
+
+func (id streamID) after(other streamID) bool {
+	return id.ms > other.ms || (id.ms == other.ms && id.seq > other.seq)
+}
+
+func newStreamIDs() *streamIDs {
+	return &streamIDs{last: map[string]streamID{}}
+}
+
+func (s *streamIDs) next(key string, t time.Time) streamID {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	id := streamID{ms: t.UnixMilli()}
+	if last, found := s.last[key]; found && id.ms <= last.ms {
+		id = streamID{ms: last.ms, seq: last.seq + 1}
+	}
+	s.last[key] = id
+	return id
+}
+
+// seed makes the next IDs of key go after top
+func (s *streamIDs) seed(key string, top streamID) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if last, found := s.last[key]; !found || top.after(last) {
+		s.last[key] = top
+	}
+}
+
+// write stores a record under key, either as the head of a list trimmed to
+// maxEntries or as a stream entry identified by the sensor time.
+func (s *Redis) write(key string, sensorTime time.Time, maxEntries int, data []byte) error {
+	key = s.key(key)
+
+	if !s.streams {
+		pipe := s.DB.Pipeline()
+		pipe.LPush(s.ctx, key, data)
+		pipe.LTrim(s.ctx, key, 0, int64(maxEntries))
+		_, err := pipe.Exec(s.ctx)
+		return err
+	}
+
+	err := s.xadd(key, sensorTime, maxEntries, data)
+	if err == nil || !strings.Contains(err.Error(), streamTopError) {
+		return err
+	}
+	// a previous run or another writer went past the sensor time
+	if err := s.seedStreamID(key); err != nil {
+		return err
+	}
+	return s.xadd(key, sensorTime, maxEntries, data)
+}
+
+func (s *Redis) xadd(key string, sensorTime time.Time, maxEntries int, data []byte) error {
+	id := s.streamIDs.next(key, sensorTime)
+	args := &redis.XAddArgs{
+		Stream: key,
+		ID:     id.String(),
+		Values: []interface{}{RedisStreamDataField, data},
+		// exact trimming is a lot more expensive for redis, a few extra entries don't matter
+		Approx: true,
+	}
+	if s.streamRetention > 0 {
+		args.MinID = fmt.Sprintf("%d", id.ms-s.streamRetention.Milliseconds())
+	} else {
+		args.MaxLen = int64(maxEntries)
+	}
+	return s.DB.XAdd(s.ctx, args).Err()
+}
+
+// seedStreamID makes the next IDs of key go after the last entry of its stream
+func (s *Redis) seedStreamID(key string) error {
+	entries, err := s.DB.XRevRangeN(s.ctx, key, "+", "-", 1).Result()
+	if err != nil {
+		return fmt.Errorf("reading the top of stream %s: %w", key, err)
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	top, err := parseStreamID(entries[0].ID)
+	if err != nil {
+		return err
+	}
+	s.streamIDs.seed(key, top)
+	return nil
+}
@@ -0,0 +1,223 @@
+package logger
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	sensordata "github.com/Hivemapper/hivemapper-data-logger/proto-out"
+	"google.golang.org/protobuf/proto"
+)
+
+// replayFileMagic starts every binary gnss replay file, the last byte is the
+// version of the format.
+var replayFileMagic = []byte("HMREPLY\x01")
+
+const ReplayIndexExtension = ".idx"
+const replayIndexEntrySize = 16
+const maxReplayRecordSize = 4 * 1024 * 1024
+
+// ReplayIndexInterval is the sensor time between two entries of the index.
+const ReplayIndexInterval = time.Second
+
+// IsJsonReplayFile tells if path uses the original replay format, JSON lines
+// of GnssReplayEvent, instead of the binary container.
+func IsJsonReplayFile(path string) bool {
+	return strings.HasSuffix(path, ".jsonl") || strings.HasSuffix(path, ".json")
+}
+
+func ReplayIndexPath(path string) string {
+	return path + ReplayIndexExtension
+}
+
+// ReplayFileWriter writes a binary gnss replay file: the magic header
+// followed by ReplayRecord messages, each prefixed by its varint encoded
+// length. A sidecar index maps the sensor time to the offset of a record
+// every ReplayIndexInterval.
+type ReplayFileWriter struct {
+	file   *os.File
+	output *bufio.Writer
+	index  *os.File
+
+	offset        int64
+	lastIndexTime time.Time
+}
+
+func NewReplayFileWriter(path string) (*ReplayFileWriter, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("creating replay file: %w", err)
+	}
+	index, err := os.Create(ReplayIndexPath(path))
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("creating replay index: %w", err)
+	}
+
+	w := &ReplayFileWriter{
+		file:   file,
+		output: bufio.NewWriter(file),
+		index:  index,
+	}
+	if _, err := w.output.Write(replayFileMagic); err != nil {
+		_ = w.Close()
+		return nil, fmt.Errorf("writing replay file header: %w", err)
+	}
+	w.offset = int64(len(replayFileMagic))
+	return w, nil
+}
+
+func (w *ReplayFileWriter) Write(key string, sensorTime time.Time, data []byte) error {
+	encoded, err := proto.Marshal(&sensordata.ReplayRecord{
+		Key:          key,
+		SensorTimeNs: sensorTime.UnixNano(),
+		SystemTimeNs: time.Now().UnixNano(),
+		Data:         data,
+	})
+	if err != nil {
+		return fmt.Errorf("marshalling replay record: %w", err)
+	}
+
+	if w.lastIndexTime.IsZero() || sensorTime.Sub(w.lastIndexTime) >= ReplayIndexInterval {
+		entry := make([]byte, replayIndexEntrySize)
+		binary.BigEndian.PutUint64(entry, uint64(sensorTime.UnixNano()))
+		binary.BigEndian.PutUint64(entry[8:], uint64(w.offset))
+		if _, err := w.index.Write(entry); err != nil {
+			return fmt.Errorf("writing replay index: %w", err)
+		}
+		w.lastIndexTime = sensorTime
+	}
+
+	length := binary.AppendUvarint(nil, uint64(len(encoded)))
+	if _, err := w.output.Write(length); err != nil {
+		return fmt.Errorf("writing replay record: %w", err)
+	}
+	if _, err := w.output.Write(encoded); err != nil {
+		return fmt.Errorf("writing replay record: %w", err)
+	}
+	w.offset += int64(len(length) + len(encoded))
+	return nil
+}
+
+func (w *ReplayFileWriter) Close() error {
+	if err := errors.Join(w.output.Flush(), w.file.Close(), w.index.Close()); err != nil {
+		return fmt.Errorf("closing replay file: %w", err)
+	}
+	return nil
+}
+
+type replayIndexEntry struct {
+	sensorTime int64
+	offset     int64
+}
+
+// ReplayFileReader reads the records of a binary gnss replay file in the
+// order they were written.
+type ReplayFileReader struct {
+	path   string
+	file   *os.File
+	reader *bufio.Reader
+}
+
+func OpenReplayFile(path string) (*ReplayFileReader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening replay file: %w", err)
+	}
+
+	r := &ReplayFileReader{
+		path:   path,
+		file:   file,
+		reader: bufio.NewReader(file),
+	}
+	header := make([]byte, len(replayFileMagic))
+	if _, err := io.ReadFull(r.reader, header); err != nil || !bytes.Equal(header, replayFileMagic) {
+		_ = file.Close()
+		return nil, fmt.Errorf("%s is not a gnss replay file", path)
+	}
+	return r, nil
+}
+
+// Next returns the next record, io.EOF at the end of the file. A record
+// truncated by a crash of the writer also ends the file.
+func (r *ReplayFileReader) Next() (*sensordata.ReplayRecord, error) {
+	length, err := binary.ReadUvarint(r.reader)
+	if err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+	if length > maxReplayRecordSize {
+		return nil, fmt.Errorf("replay record of %d bytes is too large", length)
+	}
+
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(r.reader, buf); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("reading replay record: %w", err)
+	}
+
+	record := &sensordata.ReplayRecord{}
+	if err := proto.Unmarshal(buf, record); err != nil {
+		return nil, fmt.Errorf("unmarshalling replay record: %w", err)
+	}
+	return record, nil
+}
+
+// SeekSensorTime moves the reader to the last indexed record at or before
+// sensorTime and returns the sensor time of that record, a zero time when
+// it moved to the start of the file. It fails when the file has no index.
+func (r *ReplayFileReader) SeekSensorTime(sensorTime time.Time) (time.Time, error) {
+	entries, err := readReplayIndex(ReplayIndexPath(r.path))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	offset := int64(len(replayFileMagic))
+	var landed time.Time
+	target := sensorTime.UnixNano()
+	i := sort.Search(len(entries), func(i int) bool {
+		return entries[i].sensorTime > target
+	})
+	if i > 0 {
+		offset = entries[i-1].offset
+		landed = time.Unix(0, entries[i-1].sensorTime).UTC()
+	}
+
+	if _, err := r.file.Seek(offset, io.SeekStart); err != nil {
+		return time.Time{}, fmt.Errorf("seeking replay file: %w", err)
+	}
+	r.reader.Reset(r.file)
+	return landed, nil
+}
+
+func (r *ReplayFileReader) Close() error {
+	return r.file.Close()
+}
+
+func readReplayIndex(path string) ([]replayIndexEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading replay index: %w", err)
+	}
+
+	// a partial entry left over by a crash is ignored
+	entries := make([]replayIndexEntry, 0, len(data)/replayIndexEntrySize)
+	for i := 0; i+replayIndexEntrySize <= len(data); i += replayIndexEntrySize {
+		entries = append(entries, replayIndexEntry{
+			sensorTime: int64(binary.BigEndian.Uint64(data[i:])),
+			offset:     int64(binary.BigEndian.Uint64(data[i+8:])),
+		})
+	}
+	return entries, nil
+}
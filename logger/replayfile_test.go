@@ -0,0 +1,83 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestReplayFile(t *testing.T, count int, spacing time.Duration) (string, time.Time) {
+	path := filepath.Join(t.TempDir(), "gnss.replay")
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	writer, err := NewReplayFileWriter(path)
+	require.NoError(t, err)
+	for i := 0; i < count; i++ {
+		require.NoError(t, writer.Write("NavPvt", start.Add(time.Duration(i)*spacing), []byte(fmt.Sprintf("record %d", i))))
+	}
+	require.NoError(t, writer.Close())
+	return path, start
+}
+
+func Test_ReplayFile(t *testing.T) {
+	path, start := writeTestReplayFile(t, 40, 250*time.Millisecond)
+
+	reader, err := OpenReplayFile(path)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	for i := 0; i < 40; i++ {
+		record, err := reader.Next()
+		require.NoError(t, err)
+		assert.Equal(t, "NavPvt", record.Key)
+		assert.Equal(t, start.Add(time.Duration(i)*250*time.Millisecond).UnixNano(), record.SensorTimeNs)
+		assert.NotZero(t, record.SystemTimeNs)
+		assert.Equal(t, fmt.Sprintf("record %d", i), string(record.Data))
+	}
+	_, err = reader.Next()
+	assert.Equal(t, io.EOF, err)
+
+	// indexed every second, 4 records apart
+	landed, err := reader.SeekSensorTime(start.Add(5500 * time.Millisecond))
+	require.NoError(t, err)
+	assert.Equal(t, start.Add(5*time.Second), landed)
+	record, err := reader.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "record 20", string(record.Data))
+
+	landed, err = reader.SeekSensorTime(start.Add(-time.Second))
+	require.NoError(t, err)
+	assert.True(t, landed.IsZero())
+	record, err = reader.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "record 0", string(record.Data))
+}
+
+func Test_ReplayFileTruncated(t *testing.T) {
+	path, _ := writeTestReplayFile(t, 3, time.Second)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-2))
+
+	reader, err := OpenReplayFile(path)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	for i := 0; i < 2; i++ {
+		_, err := reader.Next()
+		require.NoError(t, err)
+	}
+	_, err = reader.Next()
+	assert.Equal(t, io.EOF, err)
+
+	require.NoError(t, os.WriteFile(path, []byte("not a replay file"), 0644))
+	_, err = OpenReplayFile(path)
+	assert.Error(t, err)
+}
@@ -0,0 +1,109 @@
+package logger
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/device/neom9n"
+	"github.com/Hivemapper/hivemapper-data-logger/data/imu"
+	"github.com/streamingfast/imu-controller/device/iim42652"
+)
+
+type ImuRecord struct {
+	SystemTime   time.Time
+	Acceleration *imu.Acceleration
+	AngularRate  *iim42652.AngularRate
+	Temperature  iim42652.Temperature
+	Fsync        *iim42652.Fsync
+}
+
+func NewImuRecord(systemTime time.Time, acceleration *imu.Acceleration, angularRate *iim42652.AngularRate, temperature iim42652.Temperature, fsync *iim42652.Fsync) *ImuRecord {
+	return &ImuRecord{
+		SystemTime:   systemTime,
+		Acceleration: acceleration,
+		AngularRate:  angularRate,
+		Temperature:  temperature,
+		Fsync:        fsync,
+	}
+}
+
+type MagnetometerRecord struct {
+	SystemTime time.Time
+	X          float64
+	Y          float64
+	Z          float64
+}
+
+func NewMagnetometerRecord(systemTime time.Time, x float64, y float64, z float64) *MagnetometerRecord {
+	return &MagnetometerRecord{
+		SystemTime: systemTime,
+		X:          x,
+		Y:          y,
+		Z:          z,
+	}
+}
+
+// Sink is an output of the data logger. Every sensor record is handed to all
+// the enabled sinks. HandleUbxMessage makes any Sink usable as a
+// message.UbxMessageHandler of the gnss device.
+type Sink interface {
+	LogImu(record *ImuRecord) error
+	LogMagnetometer(record *MagnetometerRecord) error
+	HandleUbxMessage(msg interface{}) error
+	LogGnssAuth(data *neom9n.Data) error
+	Close() error
+}
+
+// Sinks fans out every record to all of its sinks. An error from one sink
+// doesn't prevent the others from receiving the record.
+type Sinks []Sink
+
+func (s Sinks) LogImu(record *ImuRecord) error {
+	var errs []error
+	for _, sink := range s {
+		if err := sink.LogImu(record); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s Sinks) LogMagnetometer(record *MagnetometerRecord) error {
+	var errs []error
+	for _, sink := range s {
+		if err := sink.LogMagnetometer(record); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s Sinks) HandleUbxMessage(msg interface{}) error {
+	var errs []error
+	for _, sink := range s {
+		if err := sink.HandleUbxMessage(msg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s Sinks) LogGnssAuth(data *neom9n.Data) error {
+	var errs []error
+	for _, sink := range s {
+		if err := sink.LogGnssAuth(data); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s Sinks) Close() error {
+	var errs []error
+	for _, sink := range s {
+		if err := sink.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
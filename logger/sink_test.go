@@ -0,0 +1,59 @@
+package logger_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/device/neom9n"
+	"github.com/Hivemapper/hivemapper-data-logger/logger"
+	"github.com/daedaleanai/ublox/ubx"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingSink struct {
+	err           error
+	magnetometers int
+	ubxMessages   int
+	closed        bool
+}
+
+func (s *recordingSink) LogImu(_ *logger.ImuRecord) error { return s.err }
+func (s *recordingSink) LogMagnetometer(_ *logger.MagnetometerRecord) error {
+	s.magnetometers++
+	return s.err
+}
+func (s *recordingSink) HandleUbxMessage(_ interface{}) error {
+	s.ubxMessages++
+	return s.err
+}
+func (s *recordingSink) LogGnssAuth(_ *neom9n.Data) error { return s.err }
+func (s *recordingSink) Close() error {
+	s.closed = true
+	return s.err
+}
+
+func Test_SinksFanOut(t *testing.T) {
+	failing := &recordingSink{err: fmt.Errorf("redis down")}
+	healthy := &recordingSink{}
+	sinks := logger.Sinks{failing, healthy}
+
+	err := sinks.LogMagnetometer(logger.NewMagnetometerRecord(time.Now(), 1, 2, 3))
+	require.EqualError(t, err, "redis down")
+	require.Equal(t, 1, failing.magnetometers)
+	require.Equal(t, 1, healthy.magnetometers)
+
+	require.NoError(t, logger.Sinks{healthy}.HandleUbxMessage(&ubx.NavPvt{}))
+	require.Equal(t, 1, healthy.ubxMessages)
+
+	otherFailing := &recordingSink{err: fmt.Errorf("disk full")}
+	err = logger.Sinks{failing, healthy, otherFailing}.Close()
+	require.EqualError(t, err, "redis down\ndisk full")
+	require.ErrorIs(t, err, otherFailing.err)
+	require.True(t, healthy.closed)
+}
+
+func Test_UbxMessageName(t *testing.T) {
+	require.Equal(t, "NavPvt", logger.UbxMessageName(&ubx.NavPvt{}))
+	require.Equal(t, "SecEcsign", logger.UbxMessageName(&ubx.SecEcsign{}))
+}
@@ -0,0 +1,307 @@
+package logger
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const spoolSegmentExtension = ".spool"
+const minSpoolSegmentSize = 64 * 1024
+
+// SpoolRecord is a redis write which couldn't be sent.
+type SpoolRecord struct {
+	Key        string
+	SensorTime time.Time
+	MaxEntries int
+	Data       []byte
+}
+
+type spoolSegment struct {
+	path   string
+	size   int64
+	offset int64
+}
+
+// Spool is a bounded on-disk FIFO of SpoolRecord. Records are appended to
+// segment files, when the spool grows over maxSize the oldest segment is
+// dropped. Records survive a restart of the logger. The drain offset doesn't,
+// the records of a segment partly drained are handled again after a restart.
+type Spool struct {
+	lock sync.Mutex
+
+	dir         string
+	maxSize     int64
+	segmentSize int64
+
+	segments    []*spoolSegment
+	current     *os.File
+	nextSegment uint64
+}
+
+func NewSpool(dir string, maxSize int64) *Spool {
+	segmentSize := maxSize / 16
+	if segmentSize < minSpoolSegmentSize {
+		segmentSize = minSpoolSegmentSize
+	}
+	return &Spool{
+		dir:         dir,
+		maxSize:     maxSize,
+		segmentSize: segmentSize,
+	}
+}
+
+// Open loads the segments left over by a previous run.
+func (s *Spool) Open() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	err := os.MkdirAll(s.dir, 0755)
+	if err != nil {
+		return fmt.Errorf("creating spool directory: %w", err)
+	}
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return fmt.Errorf("listing spool directory: %w", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), spoolSegmentExtension) {
+			names = append(names, entry.Name())
+		}
+	}
+	// names are zero padded sequence numbers, sorting them sorts the segments by age
+	sort.Strings(names)
+
+	for _, name := range names {
+		info, err := os.Stat(filepath.Join(s.dir, name))
+		if err != nil {
+			return fmt.Errorf("reading spool segment: %w", err)
+		}
+		s.segments = append(s.segments, &spoolSegment{path: filepath.Join(s.dir, name), size: info.Size()})
+
+		sequence, err := strconv.ParseUint(strings.TrimSuffix(name, spoolSegmentExtension), 10, 64)
+		if err == nil && sequence >= s.nextSegment {
+			s.nextSegment = sequence + 1
+		}
+	}
+	return nil
+}
+
+func (s *Spool) Empty() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, segment := range s.segments {
+		if segment.offset < segment.size {
+			return false
+		}
+	}
+	return true
+}
+
+// Size returns the number of bytes on disk
+func (s *Spool) Size() int64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.size()
+}
+
+func (s *Spool) size() int64 {
+	var size int64
+	for _, segment := range s.segments {
+		size += segment.size
+	}
+	return size
+}
+
+func (s *Spool) Append(record *SpoolRecord) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.current == nil || s.segments[len(s.segments)-1].size >= s.segmentSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	encoded := encodeSpoolRecord(record)
+	if _, err := s.current.Write(encoded); err != nil {
+		return fmt.Errorf("writing spool record: %w", err)
+	}
+	s.segments[len(s.segments)-1].size += int64(len(encoded))
+
+	for s.size() > s.maxSize && len(s.segments) > 1 {
+		oldest := s.segments[0]
+		fmt.Printf("redis spool over %d bytes, dropping %s\n", s.maxSize, oldest.path)
+		if err := os.Remove(oldest.path); err != nil {
+			return fmt.Errorf("removing spool segment: %w", err)
+		}
+		s.segments = s.segments[1:]
+	}
+	return nil
+}
+
+// Drain calls handle for every record, oldest first. Fully handled segments
+// are removed. When handle fails, Drain stops and the next call resumes from
+// the failed record.
+func (s *Spool) Drain(handle func(record *SpoolRecord) error) error {
+	for {
+		s.lock.Lock()
+		if len(s.segments) == 0 {
+			s.lock.Unlock()
+			return nil
+		}
+		segment := s.segments[0]
+		if len(s.segments) == 1 && s.current != nil {
+			// the segment being written gets closed so it can be drained
+			if err := s.closeCurrent(); err != nil {
+				s.lock.Unlock()
+				return err
+			}
+		}
+		s.lock.Unlock()
+
+		err := s.drainSegment(segment, handle)
+		if err != nil {
+			return err
+		}
+
+		s.lock.Lock()
+		if err := os.Remove(segment.path); err != nil && !os.IsNotExist(err) {
+			s.lock.Unlock()
+			return fmt.Errorf("removing spool segment: %w", err)
+		}
+		if len(s.segments) > 0 && s.segments[0] == segment {
+			s.segments = s.segments[1:]
+		}
+		s.lock.Unlock()
+	}
+}
+
+func (s *Spool) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.closeCurrent()
+}
+
+func (s *Spool) drainSegment(segment *spoolSegment, handle func(record *SpoolRecord) error) error {
+	file, err := os.Open(segment.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// dropped while over the size limit
+			return nil
+		}
+		return fmt.Errorf("opening spool segment: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Seek(segment.offset, io.SeekStart); err != nil {
+		return fmt.Errorf("seeking spool segment: %w", err)
+	}
+
+	s.lock.Lock()
+	remaining := segment.size - segment.offset
+	s.lock.Unlock()
+
+	reader := bufio.NewReader(file)
+	for {
+		record, size, err := decodeSpoolRecord(reader, remaining)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			// a truncated record is left over by a crash while writing, nothing to recover after it
+			fmt.Printf("skipping rest of spool segment %s: %s\n", segment.path, err)
+			return nil
+		}
+		if err := handle(record); err != nil {
+			return err
+		}
+		remaining -= size
+		s.lock.Lock()
+		segment.offset += size
+		s.lock.Unlock()
+	}
+}
+
+func (s *Spool) rotate() error {
+	if err := s.closeCurrent(); err != nil {
+		return err
+	}
+
+	path := filepath.Join(s.dir, fmt.Sprintf("%020d%s", s.nextSegment, spoolSegmentExtension))
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("creating spool segment: %w", err)
+	}
+	s.nextSegment++
+	s.current = file
+	s.segments = append(s.segments, &spoolSegment{path: path})
+	return nil
+}
+
+func (s *Spool) closeCurrent() error {
+	if s.current == nil {
+		return nil
+	}
+	err := s.current.Close()
+	s.current = nil
+	if err != nil {
+		return fmt.Errorf("closing spool segment: %w", err)
+	}
+	return nil
+}
+
+// encodeSpoolRecord lays out a record as
+// [total length uint32][key length uint16][key][unix nano int64][max entries uint32][data]
+func encodeSpoolRecord(record *SpoolRecord) []byte {
+	size := 2 + len(record.Key) + 8 + 4 + len(record.Data)
+	buf := make([]byte, 4+size)
+	binary.BigEndian.PutUint32(buf[0:], uint32(size))
+	binary.BigEndian.PutUint16(buf[4:], uint16(len(record.Key)))
+	offset := 6 + copy(buf[6:], record.Key)
+	binary.BigEndian.PutUint64(buf[offset:], uint64(record.SensorTime.UnixNano()))
+	binary.BigEndian.PutUint32(buf[offset+8:], uint32(record.MaxEntries))
+	copy(buf[offset+12:], record.Data)
+	return buf
+}
+
+// decodeSpoolRecord reads the next record of a segment with remaining bytes
+// left, a corrupted length can't make it read past them
+func decodeSpoolRecord(reader io.Reader, remaining int64) (*SpoolRecord, int64, error) {
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return nil, 0, err
+	}
+	size := binary.BigEndian.Uint32(header)
+	if int64(size) > remaining-4 {
+		return nil, 0, fmt.Errorf("spool record of %d bytes, %d left in the segment", size, remaining-4)
+	}
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(reader, buf); err != nil {
+		return nil, 0, fmt.Errorf("reading spool record: %w", err)
+	}
+
+	keyLength := int(binary.BigEndian.Uint16(buf))
+	if 2+keyLength+12 > len(buf) {
+		return nil, 0, fmt.Errorf("invalid spool record")
+	}
+	offset := 2 + keyLength
+	return &SpoolRecord{
+		Key:        string(buf[2:offset]),
+		SensorTime: time.Unix(0, int64(binary.BigEndian.Uint64(buf[offset:]))).UTC(),
+		MaxEntries: int(binary.BigEndian.Uint32(buf[offset+8:])),
+		Data:       buf[offset+12:],
+	}, int64(4 + size), nil
+}
@@ -0,0 +1,148 @@
+package logger
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func spoolRecord(i int) *SpoolRecord {
+	return &SpoolRecord{
+		Key:        "NavPvt",
+		SensorTime: time.Unix(0, int64(i)*int64(time.Millisecond)).UTC(),
+		MaxEntries: 1000,
+		Data:       []byte(fmt.Sprintf("record %d", i)),
+	}
+}
+
+func drainAll(t *testing.T, spool *Spool) []*SpoolRecord {
+	var records []*SpoolRecord
+	require.NoError(t, spool.Drain(func(record *SpoolRecord) error {
+		records = append(records, record)
+		return nil
+	}))
+	return records
+}
+
+func Test_SpoolDrainsInOrder(t *testing.T) {
+	spool := NewSpool(t.TempDir(), 1024*1024)
+	require.NoError(t, spool.Open())
+
+	for i := 0; i < 10000; i++ {
+		require.NoError(t, spool.Append(spoolRecord(i)))
+	}
+	assert.False(t, spool.Empty())
+
+	records := drainAll(t, spool)
+	require.Len(t, records, 10000)
+	for i, record := range records {
+		assert.Equal(t, spoolRecord(i), record)
+	}
+	assert.True(t, spool.Empty())
+	assert.Equal(t, int64(0), spool.Size())
+}
+
+func Test_SpoolResumesAfterFailedDrain(t *testing.T) {
+	spool := NewSpool(t.TempDir(), 1024*1024)
+	require.NoError(t, spool.Open())
+	for i := 0; i < 5; i++ {
+		require.NoError(t, spool.Append(spoolRecord(i)))
+	}
+
+	handled := 0
+	err := spool.Drain(func(record *SpoolRecord) error {
+		if handled == 3 {
+			return fmt.Errorf("redis down again")
+		}
+		handled++
+		return nil
+	})
+	require.EqualError(t, err, "redis down again")
+
+	require.NoError(t, spool.Append(spoolRecord(5)))
+	records := drainAll(t, spool)
+	require.Len(t, records, 3)
+	assert.Equal(t, spoolRecord(3), records[0])
+	assert.Equal(t, spoolRecord(5), records[2])
+}
+
+func Test_SpoolIsBounded(t *testing.T) {
+	spool := NewSpool(t.TempDir(), 4*minSpoolSegmentSize)
+	require.NoError(t, spool.Open())
+
+	for i := 0; i < 50000; i++ {
+		require.NoError(t, spool.Append(spoolRecord(i)))
+	}
+	assert.LessOrEqual(t, spool.Size(), int64(4*minSpoolSegmentSize))
+
+	// the oldest records were dropped, the newest ones are kept in order
+	records := drainAll(t, spool)
+	require.NotEmpty(t, records)
+	assert.Equal(t, spoolRecord(49999), records[len(records)-1])
+	for i := 1; i < len(records); i++ {
+		assert.True(t, records[i].SensorTime.After(records[i-1].SensorTime))
+	}
+}
+
+func Test_SpoolSurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+	spool := NewSpool(dir, 1024*1024)
+	require.NoError(t, spool.Open())
+	require.NoError(t, spool.Append(spoolRecord(1)))
+	require.NoError(t, spool.Close())
+
+	spool = NewSpool(dir, 1024*1024)
+	require.NoError(t, spool.Open())
+	require.NoError(t, spool.Append(spoolRecord(2)))
+
+	records := drainAll(t, spool)
+	require.Len(t, records, 2)
+	assert.Equal(t, spoolRecord(1), records[0])
+	assert.Equal(t, spoolRecord(2), records[1])
+}
+
+func Test_SpoolCorruptedLength(t *testing.T) {
+	dir := t.TempDir()
+	spool := NewSpool(dir, 1024*1024)
+	require.NoError(t, spool.Open())
+	require.NoError(t, spool.Append(spoolRecord(1)))
+	require.NoError(t, spool.Append(spoolRecord(2)))
+	require.NoError(t, spool.Close())
+
+	// the length of the second record asks for 4 GiB
+	path := spool.segments[0].path
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	binary.BigEndian.PutUint32(data[len(encodeSpoolRecord(spoolRecord(1))):], 0xffffffff)
+	require.NoError(t, os.WriteFile(path, data, 0644))
+
+	spool = NewSpool(dir, 1024*1024)
+	require.NoError(t, spool.Open())
+	records := drainAll(t, spool)
+	require.Len(t, records, 1, "the rest of the segment is skipped")
+	assert.Equal(t, spoolRecord(1), records[0])
+}
+
+func Test_RedisSpoolsWhenUnreachable(t *testing.T) {
+	dir := t.TempDir()
+	s := NewRedis(10, 10, 10, 10, false, "", WithRedisAddress("127.0.0.1:1"), WithRedisSpool(dir, 1024*1024))
+	s.DB = redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1})
+	s.ctx = context.Background()
+	require.NoError(t, s.spool.Open())
+
+	require.NoError(t, s.push("NavPvt", time.Now(), 10, []byte("fix")))
+	assert.True(t, s.isSpooling())
+	require.NoError(t, s.push("NavPvt", time.Now(), 10, []byte("next fix")))
+
+	records := drainAll(t, s.spool)
+	require.Len(t, records, 2)
+	assert.Equal(t, []byte("fix"), records[0].Data)
+	assert.Equal(t, []byte("next fix"), records[1].Data)
+}
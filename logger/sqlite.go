@@ -0,0 +1,214 @@
+package logger
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+const sqliteLogQueueSize = 2000
+const sqlitePurgeInterval = time.Minute
+
+type sqliteBatch struct {
+	query  string
+	fields string
+	values []any
+	count  int
+}
+
+type Sqlite struct {
+	DB   *sql.DB
+	file string
+
+	createTableQueryFuncList []CreateTableQueryFunc
+	alterTableQueryFuncList  []AlterTableQueryFunc
+	purgeQueryFuncList       []PurgeQueryFunc
+
+	logs    chan Sqlable
+	batches map[string]*sqliteBatch
+	done    chan struct{}
+	lock    sync.Mutex
+	closed  bool
+}
+
+func NewSqlite(file string, createTableQueryFuncList []CreateTableQueryFunc, alterTableQueryFuncList []AlterTableQueryFunc, purgeQueryFuncList []PurgeQueryFunc) *Sqlite {
+	return &Sqlite{
+		file:                     file,
+		createTableQueryFuncList: createTableQueryFuncList,
+		alterTableQueryFuncList:  alterTableQueryFuncList,
+		purgeQueryFuncList:       purgeQueryFuncList,
+		logs:                     make(chan Sqlable, sqliteLogQueueSize),
+		batches:                  map[string]*sqliteBatch{},
+		done:                     make(chan struct{}),
+	}
+}
+
+// Init opens the database, creates and migrates the tables and starts the
+// writer. Rows older than logTTL are purged periodically, a zero logTTL
+// disables purging.
+func (s *Sqlite) Init(logTTL time.Duration) error {
+	fmt.Println("Initializing sqlite logger:", s.file)
+	db, err := sql.Open("sqlite", s.file)
+	if err != nil {
+		return fmt.Errorf("opening database: %w", err)
+	}
+	// a single connection serializes writers, sqlite does not support concurrent writes anyway
+	db.SetMaxOpenConns(1)
+	s.DB = db
+
+	for _, pragma := range []string{"PRAGMA journal_mode=WAL;", "PRAGMA synchronous=NORMAL;"} {
+		if _, err := s.DB.Exec(pragma); err != nil {
+			return fmt.Errorf("executing %q: %w", pragma, err)
+		}
+	}
+
+	for _, createTableQueryFunc := range s.createTableQueryFuncList {
+		if _, err := s.DB.Exec(createTableQueryFunc()); err != nil {
+			return fmt.Errorf("creating table: %w", err)
+		}
+	}
+
+	for _, alterTableQueryFunc := range s.alterTableQueryFuncList {
+		if _, err := s.DB.Exec(alterTableQueryFunc()); err != nil {
+			// alter queries are re-applied on every start, the column is already there
+			if strings.Contains(err.Error(), "duplicate column name") {
+				continue
+			}
+			return fmt.Errorf("altering table: %w", err)
+		}
+	}
+
+	if logTTL > 0 {
+		if err := s.purge(logTTL); err != nil {
+			return fmt.Errorf("purging database: %w", err)
+		}
+	}
+
+	go s.run(logTTL)
+
+	fmt.Println("Sqlite logger initialized")
+	return nil
+}
+
+// Log queues the data to be written with the next batch of its table. It
+// never blocks, data is dropped when the writer can't keep up.
+func (s *Sqlite) Log(data Sqlable) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return fmt.Errorf("sqlite logger is closed")
+	}
+
+	select {
+	case s.logs <- data:
+		return nil
+	default:
+		return fmt.Errorf("sqlite log queue full, dropping data")
+	}
+}
+
+// Close flushes every pending batch and closes the database.
+func (s *Sqlite) Close() error {
+	s.lock.Lock()
+	if s.closed {
+		s.lock.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.logs)
+	s.lock.Unlock()
+
+	<-s.done
+	return s.DB.Close()
+}
+
+func (s *Sqlite) run(logTTL time.Duration) {
+	defer close(s.done)
+
+	purgeTicker := time.NewTicker(sqlitePurgeInterval)
+	defer purgeTicker.Stop()
+
+	for {
+		select {
+		case data, ok := <-s.logs:
+			if !ok {
+				if err := s.flushAll(); err != nil {
+					fmt.Printf("flushing sqlite logs: %s\n", err)
+				}
+				return
+			}
+			if err := s.add(data); err != nil {
+				fmt.Printf("writing sqlite logs: %s\n", err)
+			}
+		case <-purgeTicker.C:
+			if logTTL == 0 {
+				continue
+			}
+			if err := s.purge(logTTL); err != nil {
+				fmt.Printf("purging sqlite logs: %s\n", err)
+			}
+		}
+	}
+}
+
+func (s *Sqlite) add(data Sqlable) error {
+	query, fields, values, err := data.InsertQuery()
+	if err != nil {
+		return fmt.Errorf("building insert query: %w", err)
+	}
+
+	batch, found := s.batches[query]
+	if !found {
+		batch = &sqliteBatch{query: query, fields: fields}
+		s.batches[query] = batch
+	}
+	batch.values = append(batch.values, values...)
+	batch.count++
+
+	if batch.count < data.BufferSize() {
+		return nil
+	}
+	return s.flush(batch)
+}
+
+func (s *Sqlite) flushAll() error {
+	for _, batch := range s.batches {
+		if err := s.flush(batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Sqlite) flush(batch *sqliteBatch) error {
+	if batch.count == 0 {
+		return nil
+	}
+
+	// fields end with a ',' to be repeated once per row
+	query := batch.query + strings.TrimSuffix(strings.Repeat(batch.fields, batch.count), ",")
+	values := batch.values
+
+	batch.values = nil
+	batch.count = 0
+
+	if _, err := s.DB.Exec(query, values...); err != nil {
+		return fmt.Errorf("inserting batch: %w", err)
+	}
+	return nil
+}
+
+func (s *Sqlite) purge(logTTL time.Duration) error {
+	cutoff := time.Now().UTC().Add(-logTTL).Format("2006-01-02 15:04:05.99999")
+	for _, purgeQueryFunc := range s.purgeQueryFuncList {
+		if _, err := s.DB.Exec(purgeQueryFunc(), cutoff); err != nil {
+			return fmt.Errorf("executing purge query: %w", err)
+		}
+	}
+	return nil
+}
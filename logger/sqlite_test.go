@@ -0,0 +1,76 @@
+package logger_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Hivemapper/hivemapper-data-logger/data/gnss"
+	"github.com/Hivemapper/hivemapper-data-logger/data/imu"
+	"github.com/Hivemapper/hivemapper-data-logger/data/magnetometer"
+	"github.com/Hivemapper/hivemapper-data-logger/data/session"
+	"github.com/Hivemapper/hivemapper-data-logger/logger"
+	"github.com/daedaleanai/ublox/ubx"
+	"github.com/streamingfast/imu-controller/device/iim42652"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSqlite(file string) *logger.Sqlite {
+	return logger.NewSqlite(
+		file,
+		[]logger.CreateTableQueryFunc{imu.CreateTableQuery, gnss.CreateTableQuery, magnetometer.CreateTableQuery},
+		[]logger.AlterTableQueryFunc{imu.AlterTableQuery, gnss.AlterTableQuery, magnetometer.AlterTableQuery},
+		[]logger.PurgeQueryFunc{imu.PurgeQuery, gnss.PurgeQuery, magnetometer.PurgeQuery},
+	)
+}
+
+func countRows(t *testing.T, s *logger.Sqlite, table string) int {
+	var count int
+	require.NoError(t, s.DB.QueryRow("SELECT COUNT(*) FROM "+table).Scan(&count))
+	return count
+}
+
+func Test_SqliteLogAndPurge(t *testing.T) {
+	require.NoError(t, session.SetSession("test"))
+	file := filepath.Join(t.TempDir(), "test.db")
+
+	s := newTestSqlite(file)
+	require.NoError(t, s.Init(time.Hour))
+
+	now := time.Now().UTC()
+	temperature := iim42652.NewTemperature(25)
+	for i := 0; i < 150; i++ {
+		require.NoError(t, s.Log(imu.NewImuSqlWrapper(
+			now,
+			temperature,
+			imu.NewAcceleration(0.1, 0.2, 1.0, 1.0, now),
+			&iim42652.AngularRate{X: 1, Y: 2, Z: 3},
+			&iim42652.Fsync{TimeDelta: 5, FsyncInt: i%200 == 0},
+		)))
+	}
+	for i := 0; i < 5; i++ {
+		require.NoError(t, s.Log(gnss.NewNavPvtSqlWrapper(now, &ubx.NavPvt{ITOW_ms: uint32(i * 250), Year_y: 2024, Month_month: 1, Day_d: 1})))
+	}
+	// older than the ttl, must get purged on next start
+	require.NoError(t, s.Log(magnetometer.NewMagnetometerSqlWrapper(now.Add(-2*time.Hour), 1, 2, 3)))
+	require.NoError(t, s.Close())
+
+	// re-opening must not fail on already migrated tables
+	s = newTestSqlite(file)
+	require.NoError(t, s.Init(0))
+	assert.Equal(t, 150, countRows(t, s, "imu"))
+	assert.Equal(t, 5, countRows(t, s, "gnss"))
+	assert.Equal(t, 1, countRows(t, s, "magnetometer"))
+
+	var sessionID string
+	require.NoError(t, s.DB.QueryRow("SELECT session FROM imu LIMIT 1").Scan(&sessionID))
+	assert.Equal(t, "test", sessionID)
+	require.NoError(t, s.Close())
+
+	s = newTestSqlite(file)
+	require.NoError(t, s.Init(time.Hour))
+	assert.Equal(t, 150, countRows(t, s, "imu"))
+	assert.Equal(t, 0, countRows(t, s, "magnetometer"))
+	require.NoError(t, s.Close())
+}
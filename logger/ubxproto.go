@@ -0,0 +1,188 @@
+package logger
+
+import (
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/device/neom9n"
+	sensordata "github.com/Hivemapper/hivemapper-data-logger/proto-out"
+	"github.com/daedaleanai/ublox/ubx"
+)
+
+func navPvtProto(m *ubx.NavPvt, systemTime time.Time, upTime float64) *sensordata.NavPvt {
+	return &sensordata.NavPvt{
+		SystemTime:   systemTime.String(),
+		UptimeMs:     upTime,
+		ItowMs:       m.ITOW_ms,
+		YearY:        uint32(m.Year_y),
+		MonthMonth:   uint32(m.Month_month),
+		DayD:         uint32(m.Day_d),
+		HourH:        uint32(m.Hour_h),
+		MinMin:       uint32(m.Min_min),
+		SecS:         uint32(m.Sec_s),
+		Valid:        uint32(m.Valid),
+		TAccNs:       uint32(m.TAcc_ns),
+		NanoNs:       uint32(m.Nano_ns),
+		FixType:      uint32(m.FixType),
+		Flags:        uint32(m.Flags),
+		Flags2:       uint32(m.Flags2),
+		NumSv:        uint32(m.NumSV),
+		LonDege7:     int32(m.Lon_dege7),
+		LatDege7:     int32(m.Lat_dege7),
+		HeightMm:     int32(m.Height_mm),
+		HmslMm:       int32(m.HMSL_mm),
+		HAccMm:       uint32(m.HAcc_mm),
+		VAccMm:       uint32(m.VAcc_mm),
+		VelNMmS:      int32(m.VelN_mm_s),
+		VelEMmS:      int32(m.VelE_mm_s),
+		VelDMmS:      int32(m.VelD_mm_s),
+		GSpeedMmS:    int32(m.GSpeed_mm_s),
+		HeadMotDege5: int32(m.HeadMot_dege5),
+		SAccMmS:      uint32(m.SAcc_mm_s),
+		HeadAccDege5: int32(m.HeadAcc_dege5),
+		Pdop:         uint32(m.PDOP),
+		Flags3:       uint32(m.Flags3),
+		HeadVehDege5: int32(m.HeadVeh_dege5),
+		MagDecDege2:  int32(m.MagDec_dege2),
+		MagAccDege2:  uint32(m.MagAcc_dege2),
+	}
+}
+
+func navDopProto(m *ubx.NavDop, systemTime time.Time) *sensordata.NavDop {
+	return &sensordata.NavDop{
+		SystemTime: systemTime.String(),
+		ItowMs:     m.ITOW_ms,
+		Gdop:       uint32(m.GDOP),
+		Pdop:       uint32(m.PDOP),
+		Tdop:       uint32(m.TDOP),
+		Vdop:       uint32(m.VDOP),
+		Hdop:       uint32(m.HDOP),
+		Ndop:       uint32(m.NDOP),
+		Edop:       uint32(m.EDOP),
+	}
+}
+
+func navCovProto(m *ubx.NavCov) *sensordata.NavCov {
+	return &sensordata.NavCov{
+		ItowMs:      m.ITOW_ms,
+		Version:     uint32(m.Version),
+		PosCovValid: uint32(m.PosCovValid),
+		VelCovValid: uint32(m.VelCovValid),
+		PosCovNN:    float64(m.PosCovNN_m2),
+		PosCovNE:    float64(m.PosCovNE_m2),
+		PosCovND:    float64(m.PosCovND_m2),
+		PosCovEE:    float64(m.PosCovEE_m2),
+		PosCovED:    float64(m.PosCovED_m2),
+		PosCovDD:    float64(m.PosCovDD_m2),
+		VelCovNN:    float64(m.VelCovNN_m2_s2),
+		VelCovNE:    float64(m.VelCovNE_m2_s2),
+		VelCovND:    float64(m.VelCovND_m2_s2),
+		VelCovEE:    float64(m.VelCovEE_m2_s2),
+		VelCovED:    float64(m.VelCovED_m2_s2),
+		VelCovDD:    float64(m.VelCovDD_m2_s2),
+	}
+}
+
+func navPosecefProto(m *ubx.NavPosecef) *sensordata.NavPosecef {
+	return &sensordata.NavPosecef{
+		ItowMs:  m.ITOW_ms,
+		EcefXCm: int32(m.EcefX_cm),
+		EcefYCm: int32(m.EcefY_cm),
+		EcefZCm: int32(m.EcefZ_cm),
+		PAccCm:  uint32(m.PAcc_cm),
+	}
+}
+
+func navTimegpsProto(m *ubx.NavTimegps) *sensordata.NavTimegps {
+	return &sensordata.NavTimegps{
+		ItowMs: uint32(m.ITOW_ms),
+		FtowNs: int32(m.FTOW_ns),
+		Week:   int32(m.Week),
+		LeapS:  int32(m.LeapS_s),
+		Valid:  uint32(m.Valid),
+		TAccNs: uint32(m.TAcc_ns),
+	}
+}
+
+func navVelecefProto(m *ubx.NavVelecef) *sensordata.NavVelecef {
+	return &sensordata.NavVelecef{
+		ItowMs:    uint32(m.ITOW_ms),
+		EcefVxCmS: int32(m.EcefVX_cm_s),
+		EcefVyCmS: int32(m.EcefVY_cm_s),
+		EcefVzCmS: int32(m.EcefVZ_cm_s),
+		SAccCmS:   uint32(m.SAcc_cm_s),
+	}
+}
+
+func navStatusProto(m *ubx.NavStatus) *sensordata.NavStatus {
+	return &sensordata.NavStatus{
+		ItowMs:  uint32(m.ITOW_ms),
+		GpsFix:  uint32(m.GpsFix),
+		Flags:   uint32(m.Flags),
+		FixStat: uint32(m.FixStat),
+		Flags2:  uint32(m.Flags2),
+		Ttff:    uint32(m.Ttff_ms),
+		Msss:    uint32(m.Msss_ms),
+	}
+}
+
+func navSigProto(m *ubx.NavSig, systemTime time.Time) *sensordata.NavSig {
+	protomessage := &sensordata.NavSig{
+		SystemTime: systemTime.String(),
+		ItowMs:     m.ITOW_ms,
+		Version:    uint32(m.Version),
+		NumSigs:    uint32(m.NumSigs),
+	}
+	protomessage.Sigs = make([]*sensordata.NavSig_Sigs, len(m.Sigs))
+	for i, sig := range m.Sigs {
+		protomessage.Sigs[i] = &sensordata.NavSig_Sigs{
+			GnssId:     uint32(sig.GnssId),
+			SvId:       uint32(sig.SvId),
+			SigId:      uint32(sig.SigId),
+			FreqId:     uint32(sig.FreqId),
+			PrResMe1:   int32(sig.PrRes_me1),
+			CnoDbhz:    uint32(sig.Cno_dbhz),
+			QualityInd: uint32(sig.QualityInd),
+			CorrSource: uint32(sig.CorrSource),
+			IonoModel:  uint32(sig.IonoModel),
+			SigFlags:   uint32(sig.SigFlags),
+		}
+	}
+	return protomessage
+}
+
+// gnssEpochProto converts the messages of the epoch, they all get the system
+// time of the epoch
+func gnssEpochProto(e *neom9n.Epoch) *sensordata.GnssEpoch {
+	protomessage := &sensordata.GnssEpoch{
+		SystemTime: e.SystemTime.String(),
+		ItowMs:     e.ITOW,
+		Complete:   e.Complete(),
+		Flags:      uint32(e.Flags),
+		Missing:    uint32(e.Missing()),
+	}
+	if e.NavPvt != nil {
+		protomessage.NavPvt = navPvtProto(e.NavPvt, e.SystemTime, 0)
+	}
+	if e.NavCov != nil {
+		protomessage.NavCov = navCovProto(e.NavCov)
+	}
+	if e.NavDop != nil {
+		protomessage.NavDop = navDopProto(e.NavDop, e.SystemTime)
+	}
+	if e.NavPosecef != nil {
+		protomessage.NavPosecef = navPosecefProto(e.NavPosecef)
+	}
+	if e.NavTimegps != nil {
+		protomessage.NavTimegps = navTimegpsProto(e.NavTimegps)
+	}
+	if e.NavVelecef != nil {
+		protomessage.NavVelecef = navVelecefProto(e.NavVelecef)
+	}
+	if e.NavStatus != nil {
+		protomessage.NavStatus = navStatusProto(e.NavStatus)
+	}
+	if e.NavSig != nil {
+		protomessage.NavSig = navSigProto(e.NavSig, e.SystemTime)
+	}
+	return protomessage
+}
@@ -20,6 +20,57 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// AuthStatus tells if the hash and signature of sec_ecsign match the
+// frames of sec_ecsign_buffer
+type GnssData_AuthStatus int32
+
+const (
+	GnssData_AUTH_STATUS_UNKNOWN  GnssData_AuthStatus = 0
+	GnssData_AUTH_STATUS_VERIFIED GnssData_AuthStatus = 1
+	GnssData_AUTH_STATUS_FAILED   GnssData_AuthStatus = 2
+)
+
+// Enum value maps for GnssData_AuthStatus.
+var (
+	GnssData_AuthStatus_name = map[int32]string{
+		0: "AUTH_STATUS_UNKNOWN",
+		1: "AUTH_STATUS_VERIFIED",
+		2: "AUTH_STATUS_FAILED",
+	}
+	GnssData_AuthStatus_value = map[string]int32{
+		"AUTH_STATUS_UNKNOWN":  0,
+		"AUTH_STATUS_VERIFIED": 1,
+		"AUTH_STATUS_FAILED":   2,
+	}
+)
+
+func (x GnssData_AuthStatus) Enum() *GnssData_AuthStatus {
+	p := new(GnssData_AuthStatus)
+	*p = x
+	return p
+}
+
+func (x GnssData_AuthStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GnssData_AuthStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_sensordata_proto_enumTypes[0].Descriptor()
+}
+
+func (GnssData_AuthStatus) Type() protoreflect.EnumType {
+	return &file_sensordata_proto_enumTypes[0]
+}
+
+func (x GnssData_AuthStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GnssData_AuthStatus.Descriptor instead.
+func (GnssData_AuthStatus) EnumDescriptor() ([]byte, []int) {
+	return file_sensordata_proto_rawDescGZIP(), []int{4, 0}
+}
+
 type ImuData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -178,6 +229,104 @@ func (x *MagnetometerData) GetZ() float64 {
 	return 0
 }
 
+// ImuDataBatch packs consecutive ImuData samples, oldest first, into a
+// single redis entry
+type ImuDataBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Samples []*ImuData `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *ImuDataBatch) Reset() {
+	*x = ImuDataBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sensordata_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImuDataBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImuDataBatch) ProtoMessage() {}
+
+func (x *ImuDataBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_sensordata_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImuDataBatch.ProtoReflect.Descriptor instead.
+func (*ImuDataBatch) Descriptor() ([]byte, []int) {
+	return file_sensordata_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ImuDataBatch) GetSamples() []*ImuData {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+// MagnetometerDataBatch packs consecutive MagnetometerData samples, oldest
+// first, into a single redis entry
+type MagnetometerDataBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Samples []*MagnetometerData `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *MagnetometerDataBatch) Reset() {
+	*x = MagnetometerDataBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sensordata_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MagnetometerDataBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MagnetometerDataBatch) ProtoMessage() {}
+
+func (x *MagnetometerDataBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_sensordata_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MagnetometerDataBatch.ProtoReflect.Descriptor instead.
+func (*MagnetometerDataBatch) Descriptor() ([]byte, []int) {
+	return file_sensordata_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MagnetometerDataBatch) GetSamples() []*MagnetometerData {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
 type GnssData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -186,12 +335,13 @@ type GnssData struct {
 	SystemTime      string                 `protobuf:"bytes,1,opt,name=system_time,json=systemTime,proto3" json:"system_time,omitempty"`
 	SecEcsign       *GnssData_UbxSecEcsign `protobuf:"bytes,2,opt,name=sec_ecsign,json=secEcsign,proto3" json:"sec_ecsign,omitempty"`
 	SecEcsignBuffer string                 `protobuf:"bytes,3,opt,name=sec_ecsign_buffer,json=secEcsignBuffer,proto3" json:"sec_ecsign_buffer,omitempty"`
+	AuthStatus      GnssData_AuthStatus    `protobuf:"varint,4,opt,name=auth_status,json=authStatus,proto3,enum=GnssData_AuthStatus" json:"auth_status,omitempty"`
 }
 
 func (x *GnssData) Reset() {
 	*x = GnssData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[2]
+		mi := &file_sensordata_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -204,7 +354,7 @@ func (x *GnssData) String() string {
 func (*GnssData) ProtoMessage() {}
 
 func (x *GnssData) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[2]
+	mi := &file_sensordata_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -217,7 +367,7 @@ func (x *GnssData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GnssData.ProtoReflect.Descriptor instead.
 func (*GnssData) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{2}
+	return file_sensordata_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GnssData) GetSystemTime() string {
@@ -241,6 +391,13 @@ func (x *GnssData) GetSecEcsignBuffer() string {
 	return ""
 }
 
+func (x *GnssData) GetAuthStatus() GnssData_AuthStatus {
+	if x != nil {
+		return x.AuthStatus
+	}
+	return GnssData_AUTH_STATUS_UNKNOWN
+}
+
 type NavDop struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -260,7 +417,7 @@ type NavDop struct {
 func (x *NavDop) Reset() {
 	*x = NavDop{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[3]
+		mi := &file_sensordata_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -273,7 +430,7 @@ func (x *NavDop) String() string {
 func (*NavDop) ProtoMessage() {}
 
 func (x *NavDop) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[3]
+	mi := &file_sensordata_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -286,7 +443,7 @@ func (x *NavDop) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NavDop.ProtoReflect.Descriptor instead.
 func (*NavDop) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{3}
+	return file_sensordata_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *NavDop) GetSystemTime() string {
@@ -367,7 +524,7 @@ type NavSat struct {
 func (x *NavSat) Reset() {
 	*x = NavSat{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[4]
+		mi := &file_sensordata_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -380,7 +537,7 @@ func (x *NavSat) String() string {
 func (*NavSat) ProtoMessage() {}
 
 func (x *NavSat) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[4]
+	mi := &file_sensordata_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -393,7 +550,7 @@ func (x *NavSat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NavSat.ProtoReflect.Descriptor instead.
 func (*NavSat) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{4}
+	return file_sensordata_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *NavSat) GetSystemTime() string {
@@ -446,7 +603,7 @@ type NavSig struct {
 func (x *NavSig) Reset() {
 	*x = NavSig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[5]
+		mi := &file_sensordata_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -459,7 +616,7 @@ func (x *NavSig) String() string {
 func (*NavSig) ProtoMessage() {}
 
 func (x *NavSig) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[5]
+	mi := &file_sensordata_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,7 +629,7 @@ func (x *NavSig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NavSig.ProtoReflect.Descriptor instead.
 func (*NavSig) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{5}
+	return file_sensordata_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *NavSig) GetSystemTime() string {
@@ -554,7 +711,7 @@ type NavPvt struct {
 func (x *NavPvt) Reset() {
 	*x = NavPvt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[6]
+		mi := &file_sensordata_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -567,7 +724,7 @@ func (x *NavPvt) String() string {
 func (*NavPvt) ProtoMessage() {}
 
 func (x *NavPvt) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[6]
+	mi := &file_sensordata_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -580,7 +737,7 @@ func (x *NavPvt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NavPvt.ProtoReflect.Descriptor instead.
 func (*NavPvt) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{6}
+	return file_sensordata_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *NavPvt) GetSystemTime() string {
@@ -847,7 +1004,7 @@ type NavCov struct {
 func (x *NavCov) Reset() {
 	*x = NavCov{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[7]
+		mi := &file_sensordata_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -860,7 +1017,7 @@ func (x *NavCov) String() string {
 func (*NavCov) ProtoMessage() {}
 
 func (x *NavCov) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[7]
+	mi := &file_sensordata_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -873,7 +1030,7 @@ func (x *NavCov) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NavCov.ProtoReflect.Descriptor instead.
 func (*NavCov) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{7}
+	return file_sensordata_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *NavCov) GetItowMs() uint32 {
@@ -1003,7 +1160,7 @@ type NavPosecef struct {
 func (x *NavPosecef) Reset() {
 	*x = NavPosecef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[8]
+		mi := &file_sensordata_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1016,7 +1173,7 @@ func (x *NavPosecef) String() string {
 func (*NavPosecef) ProtoMessage() {}
 
 func (x *NavPosecef) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[8]
+	mi := &file_sensordata_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1029,7 +1186,7 @@ func (x *NavPosecef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NavPosecef.ProtoReflect.Descriptor instead.
 func (*NavPosecef) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{8}
+	return file_sensordata_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *NavPosecef) GetItowMs() uint32 {
@@ -1083,7 +1240,7 @@ type NavTimegps struct {
 func (x *NavTimegps) Reset() {
 	*x = NavTimegps{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[9]
+		mi := &file_sensordata_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1096,7 +1253,7 @@ func (x *NavTimegps) String() string {
 func (*NavTimegps) ProtoMessage() {}
 
 func (x *NavTimegps) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[9]
+	mi := &file_sensordata_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1109,7 +1266,7 @@ func (x *NavTimegps) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NavTimegps.ProtoReflect.Descriptor instead.
 func (*NavTimegps) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{9}
+	return file_sensordata_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *NavTimegps) GetItowMs() uint32 {
@@ -1169,7 +1326,7 @@ type NavVelecef struct {
 func (x *NavVelecef) Reset() {
 	*x = NavVelecef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[10]
+		mi := &file_sensordata_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1182,7 +1339,7 @@ func (x *NavVelecef) String() string {
 func (*NavVelecef) ProtoMessage() {}
 
 func (x *NavVelecef) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[10]
+	mi := &file_sensordata_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1195,7 +1352,7 @@ func (x *NavVelecef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NavVelecef.ProtoReflect.Descriptor instead.
 func (*NavVelecef) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{10}
+	return file_sensordata_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *NavVelecef) GetItowMs() uint32 {
@@ -1250,7 +1407,7 @@ type NavStatus struct {
 func (x *NavStatus) Reset() {
 	*x = NavStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[11]
+		mi := &file_sensordata_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1263,7 +1420,7 @@ func (x *NavStatus) String() string {
 func (*NavStatus) ProtoMessage() {}
 
 func (x *NavStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[11]
+	mi := &file_sensordata_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1276,7 +1433,7 @@ func (x *NavStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NavStatus.ProtoReflect.Descriptor instead.
 func (*NavStatus) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{11}
+	return file_sensordata_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *NavStatus) GetItowMs() uint32 {
@@ -1342,7 +1499,7 @@ type MonRf struct {
 func (x *MonRf) Reset() {
 	*x = MonRf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[12]
+		mi := &file_sensordata_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1355,7 +1512,7 @@ func (x *MonRf) String() string {
 func (*MonRf) ProtoMessage() {}
 
 func (x *MonRf) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[12]
+	mi := &file_sensordata_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1368,7 +1525,7 @@ func (x *MonRf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MonRf.ProtoReflect.Descriptor instead.
 func (*MonRf) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{12}
+	return file_sensordata_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *MonRf) GetSystemTime() string {
@@ -1422,7 +1579,7 @@ type RxmMeasx struct {
 func (x *RxmMeasx) Reset() {
 	*x = RxmMeasx{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[13]
+		mi := &file_sensordata_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1435,7 +1592,7 @@ func (x *RxmMeasx) String() string {
 func (*RxmMeasx) ProtoMessage() {}
 
 func (x *RxmMeasx) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[13]
+	mi := &file_sensordata_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1448,7 +1605,7 @@ func (x *RxmMeasx) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RxmMeasx.ProtoReflect.Descriptor instead.
 func (*RxmMeasx) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{13}
+	return file_sensordata_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *RxmMeasx) GetSystemTime() string {
@@ -1560,7 +1717,7 @@ type RxmRawx struct {
 func (x *RxmRawx) Reset() {
 	*x = RxmRawx{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[14]
+		mi := &file_sensordata_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1573,7 +1730,7 @@ func (x *RxmRawx) String() string {
 func (*RxmRawx) ProtoMessage() {}
 
 func (x *RxmRawx) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[14]
+	mi := &file_sensordata_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1586,7 +1743,7 @@ func (x *RxmRawx) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RxmRawx.ProtoReflect.Descriptor instead.
 func (*RxmRawx) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{14}
+	return file_sensordata_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RxmRawx) GetSystemTime() string {
@@ -1664,7 +1821,7 @@ type RxmSfrbx struct {
 func (x *RxmSfrbx) Reset() {
 	*x = RxmSfrbx{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[15]
+		mi := &file_sensordata_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1677,7 +1834,7 @@ func (x *RxmSfrbx) String() string {
 func (*RxmSfrbx) ProtoMessage() {}
 
 func (x *RxmSfrbx) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[15]
+	mi := &file_sensordata_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1690,7 +1847,7 @@ func (x *RxmSfrbx) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RxmSfrbx.ProtoReflect.Descriptor instead.
 func (*RxmSfrbx) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{15}
+	return file_sensordata_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *RxmSfrbx) GetSystemTime() string {
@@ -1773,7 +1930,7 @@ type TimTp struct {
 func (x *TimTp) Reset() {
 	*x = TimTp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[16]
+		mi := &file_sensordata_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1786,7 +1943,7 @@ func (x *TimTp) String() string {
 func (*TimTp) ProtoMessage() {}
 
 func (x *TimTp) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[16]
+	mi := &file_sensordata_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1799,7 +1956,7 @@ func (x *TimTp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimTp.ProtoReflect.Descriptor instead.
 func (*TimTp) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{16}
+	return file_sensordata_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *TimTp) GetSystemTime() string {
@@ -1851,6 +2008,226 @@ func (x *TimTp) GetRefInfo() uint32 {
 	return 0
 }
 
+// GnssEpoch is every navigation message of one solution of the receiver,
+// grouped by itow_ms. flags and missing are bit sets of the messages, in the
+// order of the fields below, with NAV-EOE as bit 8. complete is set when the
+// epoch was closed by its NAV-EOE and no expected message is missing.
+type GnssEpoch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SystemTime string      `protobuf:"bytes,1,opt,name=system_time,json=systemTime,proto3" json:"system_time,omitempty"`
+	ItowMs     uint32      `protobuf:"varint,2,opt,name=itow_ms,json=itowMs,proto3" json:"itow_ms,omitempty"`
+	Complete   bool        `protobuf:"varint,3,opt,name=complete,proto3" json:"complete,omitempty"`
+	Flags      uint32      `protobuf:"varint,4,opt,name=flags,proto3" json:"flags,omitempty"`
+	Missing    uint32      `protobuf:"varint,5,opt,name=missing,proto3" json:"missing,omitempty"`
+	NavPvt     *NavPvt     `protobuf:"bytes,6,opt,name=nav_pvt,json=navPvt,proto3" json:"nav_pvt,omitempty"`
+	NavCov     *NavCov     `protobuf:"bytes,7,opt,name=nav_cov,json=navCov,proto3" json:"nav_cov,omitempty"`
+	NavDop     *NavDop     `protobuf:"bytes,8,opt,name=nav_dop,json=navDop,proto3" json:"nav_dop,omitempty"`
+	NavPosecef *NavPosecef `protobuf:"bytes,9,opt,name=nav_posecef,json=navPosecef,proto3" json:"nav_posecef,omitempty"`
+	NavTimegps *NavTimegps `protobuf:"bytes,10,opt,name=nav_timegps,json=navTimegps,proto3" json:"nav_timegps,omitempty"`
+	NavVelecef *NavVelecef `protobuf:"bytes,11,opt,name=nav_velecef,json=navVelecef,proto3" json:"nav_velecef,omitempty"`
+	NavStatus  *NavStatus  `protobuf:"bytes,12,opt,name=nav_status,json=navStatus,proto3" json:"nav_status,omitempty"`
+	NavSig     *NavSig     `protobuf:"bytes,13,opt,name=nav_sig,json=navSig,proto3" json:"nav_sig,omitempty"`
+}
+
+func (x *GnssEpoch) Reset() {
+	*x = GnssEpoch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sensordata_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GnssEpoch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GnssEpoch) ProtoMessage() {}
+
+func (x *GnssEpoch) ProtoReflect() protoreflect.Message {
+	mi := &file_sensordata_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GnssEpoch.ProtoReflect.Descriptor instead.
+func (*GnssEpoch) Descriptor() ([]byte, []int) {
+	return file_sensordata_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GnssEpoch) GetSystemTime() string {
+	if x != nil {
+		return x.SystemTime
+	}
+	return ""
+}
+
+func (x *GnssEpoch) GetItowMs() uint32 {
+	if x != nil {
+		return x.ItowMs
+	}
+	return 0
+}
+
+func (x *GnssEpoch) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+func (x *GnssEpoch) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *GnssEpoch) GetMissing() uint32 {
+	if x != nil {
+		return x.Missing
+	}
+	return 0
+}
+
+func (x *GnssEpoch) GetNavPvt() *NavPvt {
+	if x != nil {
+		return x.NavPvt
+	}
+	return nil
+}
+
+func (x *GnssEpoch) GetNavCov() *NavCov {
+	if x != nil {
+		return x.NavCov
+	}
+	return nil
+}
+
+func (x *GnssEpoch) GetNavDop() *NavDop {
+	if x != nil {
+		return x.NavDop
+	}
+	return nil
+}
+
+func (x *GnssEpoch) GetNavPosecef() *NavPosecef {
+	if x != nil {
+		return x.NavPosecef
+	}
+	return nil
+}
+
+func (x *GnssEpoch) GetNavTimegps() *NavTimegps {
+	if x != nil {
+		return x.NavTimegps
+	}
+	return nil
+}
+
+func (x *GnssEpoch) GetNavVelecef() *NavVelecef {
+	if x != nil {
+		return x.NavVelecef
+	}
+	return nil
+}
+
+func (x *GnssEpoch) GetNavStatus() *NavStatus {
+	if x != nil {
+		return x.NavStatus
+	}
+	return nil
+}
+
+func (x *GnssEpoch) GetNavSig() *NavSig {
+	if x != nil {
+		return x.NavSig
+	}
+	return nil
+}
+
+// ReplayRecord is one entry of a gnss replay file, data is the message
+// written to the redis key.
+type ReplayRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key          string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	SensorTimeNs int64  `protobuf:"varint,2,opt,name=sensor_time_ns,json=sensorTimeNs,proto3" json:"sensor_time_ns,omitempty"`
+	SystemTimeNs int64  `protobuf:"varint,3,opt,name=system_time_ns,json=systemTimeNs,proto3" json:"system_time_ns,omitempty"`
+	Data         []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ReplayRecord) Reset() {
+	*x = ReplayRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sensordata_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayRecord) ProtoMessage() {}
+
+func (x *ReplayRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_sensordata_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayRecord.ProtoReflect.Descriptor instead.
+func (*ReplayRecord) Descriptor() ([]byte, []int) {
+	return file_sensordata_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReplayRecord) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ReplayRecord) GetSensorTimeNs() int64 {
+	if x != nil {
+		return x.SensorTimeNs
+	}
+	return 0
+}
+
+func (x *ReplayRecord) GetSystemTimeNs() int64 {
+	if x != nil {
+		return x.SystemTimeNs
+	}
+	return 0
+}
+
+func (x *ReplayRecord) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 type ImuData_AccelerometerData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1864,7 +2241,7 @@ type ImuData_AccelerometerData struct {
 func (x *ImuData_AccelerometerData) Reset() {
 	*x = ImuData_AccelerometerData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[17]
+		mi := &file_sensordata_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1877,7 +2254,7 @@ func (x *ImuData_AccelerometerData) String() string {
 func (*ImuData_AccelerometerData) ProtoMessage() {}
 
 func (x *ImuData_AccelerometerData) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[17]
+	mi := &file_sensordata_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1927,7 +2304,7 @@ type ImuData_GyroscopeData struct {
 func (x *ImuData_GyroscopeData) Reset() {
 	*x = ImuData_GyroscopeData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[18]
+		mi := &file_sensordata_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1940,7 +2317,7 @@ func (x *ImuData_GyroscopeData) String() string {
 func (*ImuData_GyroscopeData) ProtoMessage() {}
 
 func (x *ImuData_GyroscopeData) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[18]
+	mi := &file_sensordata_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1989,7 +2366,7 @@ type ImuData_FsyncData struct {
 func (x *ImuData_FsyncData) Reset() {
 	*x = ImuData_FsyncData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[19]
+		mi := &file_sensordata_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2002,7 +2379,7 @@ func (x *ImuData_FsyncData) String() string {
 func (*ImuData_FsyncData) ProtoMessage() {}
 
 func (x *ImuData_FsyncData) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[19]
+	mi := &file_sensordata_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2048,7 +2425,7 @@ type GnssData_UbxSecEcsign struct {
 func (x *GnssData_UbxSecEcsign) Reset() {
 	*x = GnssData_UbxSecEcsign{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[20]
+		mi := &file_sensordata_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2061,7 +2438,7 @@ func (x *GnssData_UbxSecEcsign) String() string {
 func (*GnssData_UbxSecEcsign) ProtoMessage() {}
 
 func (x *GnssData_UbxSecEcsign) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[20]
+	mi := &file_sensordata_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2074,7 +2451,7 @@ func (x *GnssData_UbxSecEcsign) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GnssData_UbxSecEcsign.ProtoReflect.Descriptor instead.
 func (*GnssData_UbxSecEcsign) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{2, 0}
+	return file_sensordata_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *GnssData_UbxSecEcsign) GetVersion() uint32 {
@@ -2136,7 +2513,7 @@ type NavSat_Svs struct {
 func (x *NavSat_Svs) Reset() {
 	*x = NavSat_Svs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[21]
+		mi := &file_sensordata_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2149,7 +2526,7 @@ func (x *NavSat_Svs) String() string {
 func (*NavSat_Svs) ProtoMessage() {}
 
 func (x *NavSat_Svs) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[21]
+	mi := &file_sensordata_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2162,7 +2539,7 @@ func (x *NavSat_Svs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NavSat_Svs.ProtoReflect.Descriptor instead.
 func (*NavSat_Svs) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{4, 0}
+	return file_sensordata_proto_rawDescGZIP(), []int{6, 0}
 }
 
 func (x *NavSat_Svs) GetGnssId() uint32 {
@@ -2234,7 +2611,7 @@ type NavSig_Sigs struct {
 func (x *NavSig_Sigs) Reset() {
 	*x = NavSig_Sigs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[22]
+		mi := &file_sensordata_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2247,7 +2624,7 @@ func (x *NavSig_Sigs) String() string {
 func (*NavSig_Sigs) ProtoMessage() {}
 
 func (x *NavSig_Sigs) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[22]
+	mi := &file_sensordata_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2260,7 +2637,7 @@ func (x *NavSig_Sigs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NavSig_Sigs.ProtoReflect.Descriptor instead.
 func (*NavSig_Sigs) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{5, 0}
+	return file_sensordata_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (x *NavSig_Sigs) GetGnssId() uint32 {
@@ -2355,7 +2732,7 @@ type MonRf_RFBlock struct {
 func (x *MonRf_RFBlock) Reset() {
 	*x = MonRf_RFBlock{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[23]
+		mi := &file_sensordata_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2368,7 +2745,7 @@ func (x *MonRf_RFBlock) String() string {
 func (*MonRf_RFBlock) ProtoMessage() {}
 
 func (x *MonRf_RFBlock) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[23]
+	mi := &file_sensordata_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2381,7 +2758,7 @@ func (x *MonRf_RFBlock) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MonRf_RFBlock.ProtoReflect.Descriptor instead.
 func (*MonRf_RFBlock) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{12, 0}
+	return file_sensordata_proto_rawDescGZIP(), []int{14, 0}
 }
 
 func (x *MonRf_RFBlock) GetBlockId() uint32 {
@@ -2489,7 +2866,7 @@ type RxmMeasx_RxmMeasxSVType struct {
 func (x *RxmMeasx_RxmMeasxSVType) Reset() {
 	*x = RxmMeasx_RxmMeasxSVType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[24]
+		mi := &file_sensordata_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2502,7 +2879,7 @@ func (x *RxmMeasx_RxmMeasxSVType) String() string {
 func (*RxmMeasx_RxmMeasxSVType) ProtoMessage() {}
 
 func (x *RxmMeasx_RxmMeasxSVType) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[24]
+	mi := &file_sensordata_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2515,7 +2892,7 @@ func (x *RxmMeasx_RxmMeasxSVType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RxmMeasx_RxmMeasxSVType.ProtoReflect.Descriptor instead.
 func (*RxmMeasx_RxmMeasxSVType) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{13, 0}
+	return file_sensordata_proto_rawDescGZIP(), []int{15, 0}
 }
 
 func (x *RxmMeasx_RxmMeasxSVType) GetGnssId() uint32 {
@@ -2618,7 +2995,7 @@ type RxmRawx_RxmRawxMeasType struct {
 func (x *RxmRawx_RxmRawxMeasType) Reset() {
 	*x = RxmRawx_RxmRawxMeasType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[25]
+		mi := &file_sensordata_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2631,7 +3008,7 @@ func (x *RxmRawx_RxmRawxMeasType) String() string {
 func (*RxmRawx_RxmRawxMeasType) ProtoMessage() {}
 
 func (x *RxmRawx_RxmRawxMeasType) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[25]
+	mi := &file_sensordata_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2644,7 +3021,7 @@ func (x *RxmRawx_RxmRawxMeasType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RxmRawx_RxmRawxMeasType.ProtoReflect.Descriptor instead.
 func (*RxmRawx_RxmRawxMeasType) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{14, 0}
+	return file_sensordata_proto_rawDescGZIP(), []int{16, 0}
 }
 
 func (x *RxmRawx_RxmRawxMeasType) GetPrMes() float64 {
@@ -2749,7 +3126,7 @@ type RxmSfrbx_WordBlock struct {
 func (x *RxmSfrbx_WordBlock) Reset() {
 	*x = RxmSfrbx_WordBlock{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sensordata_proto_msgTypes[26]
+		mi := &file_sensordata_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2762,7 +3139,7 @@ func (x *RxmSfrbx_WordBlock) String() string {
 func (*RxmSfrbx_WordBlock) ProtoMessage() {}
 
 func (x *RxmSfrbx_WordBlock) ProtoReflect() protoreflect.Message {
-	mi := &file_sensordata_proto_msgTypes[26]
+	mi := &file_sensordata_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2775,7 +3152,7 @@ func (x *RxmSfrbx_WordBlock) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RxmSfrbx_WordBlock.ProtoReflect.Descriptor instead.
 func (*RxmSfrbx_WordBlock) Descriptor() ([]byte, []int) {
-	return file_sensordata_proto_rawDescGZIP(), []int{15, 0}
+	return file_sensordata_proto_rawDescGZIP(), []int{17, 0}
 }
 
 func (x *RxmSfrbx_WordBlock) GetDwrd() uint32 {
@@ -2823,376 +3200,430 @@ var file_sensordata_proto_rawDesc = []byte{
 	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12,
 	0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a,
 	0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x7a, 0x22, 0xd7, 0x02, 0x0a, 0x08, 0x47, 0x6e,
-	0x73, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x5f, 0x65,
-	0x63, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x47, 0x6e,
-	0x73, 0x73, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x55, 0x62, 0x78, 0x53, 0x65, 0x63, 0x45, 0x63, 0x73,
-	0x69, 0x67, 0x6e, 0x52, 0x09, 0x73, 0x65, 0x63, 0x45, 0x63, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x2a,
-	0x0a, 0x11, 0x73, 0x65, 0x63, 0x5f, 0x65, 0x63, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x62, 0x75, 0x66,
-	0x66, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x45, 0x63,
-	0x73, 0x69, 0x67, 0x6e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x1a, 0xc6, 0x01, 0x0a, 0x0c, 0x55,
-	0x62, 0x78, 0x53, 0x65, 0x63, 0x45, 0x63, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x64, 0x30, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x64, 0x30, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4e, 0x75, 0x6d, 0x12, 0x1d, 0x0a, 0x0a,
-	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x63,
-	0x64, 0x73, 0x61, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0e, 0x65, 0x63, 0x64, 0x73, 0x61, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x22, 0xce, 0x01, 0x0a, 0x06, 0x4e, 0x61, 0x76, 0x44, 0x6f, 0x70, 0x12, 0x1f,
-	0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x17, 0x0a, 0x07, 0x69, 0x74, 0x6f, 0x77, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x69, 0x74, 0x6f, 0x77, 0x4d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x64, 0x6f, 0x70,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x67, 0x64, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x64, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x64, 0x6f, 0x70,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x64, 0x6f, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
-	0x74, 0x64, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x64, 0x6f, 0x70, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x76, 0x64, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x64, 0x6f, 0x70,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x68, 0x64, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x64, 0x6f, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6e, 0x64, 0x6f, 0x70,
-	0x12, 0x12, 0x0a, 0x04, 0x65, 0x64, 0x6f, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
-	0x65, 0x64, 0x6f, 0x70, 0x22, 0xcf, 0x02, 0x0a, 0x06, 0x4e, 0x61, 0x76, 0x53, 0x61, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x6f, 0x77, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x06, 0x69, 0x74, 0x6f, 0x77, 0x4d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x76, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x53, 0x76, 0x73, 0x12, 0x1d, 0x0a, 0x03,
-	0x73, 0x76, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4e, 0x61, 0x76, 0x53,
-	0x61, 0x74, 0x2e, 0x53, 0x76, 0x73, 0x52, 0x03, 0x73, 0x76, 0x73, 0x1a, 0xb8, 0x01, 0x0a, 0x03,
-	0x53, 0x76, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x6e, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x67, 0x6e, 0x73, 0x73, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05,
-	0x73, 0x76, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x76, 0x49,
-	0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6e, 0x6f, 0x5f, 0x64, 0x62, 0x68, 0x7a, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6e, 0x6f, 0x44, 0x62, 0x68, 0x7a, 0x12, 0x19, 0x0a, 0x08,
-	0x65, 0x6c, 0x65, 0x76, 0x5f, 0x64, 0x65, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
-	0x65, 0x6c, 0x65, 0x76, 0x44, 0x65, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x7a, 0x69, 0x6d, 0x5f,
-	0x64, 0x65, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61, 0x7a, 0x69, 0x6d, 0x44,
-	0x65, 0x67, 0x12, 0x1c, 0x0a, 0x0a, 0x70, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x5f, 0x6d, 0x65, 0x31,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x52, 0x65, 0x73, 0x4d, 0x65, 0x31,
-	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0xb7, 0x03, 0x0a, 0x06, 0x4e, 0x61, 0x76, 0x53, 0x69,
-	0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x6f, 0x77, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x74, 0x6f, 0x77, 0x4d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x69, 0x67,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x53, 0x69, 0x67, 0x73,
-	0x12, 0x20, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x4e, 0x61, 0x76, 0x53, 0x69, 0x67, 0x2e, 0x53, 0x69, 0x67, 0x73, 0x52, 0x04, 0x73, 0x69,
-	0x67, 0x73, 0x1a, 0x9b, 0x02, 0x0a, 0x04, 0x53, 0x69, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67,
-	0x6e, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x67, 0x6e,
-	0x73, 0x73, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x73, 0x76, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x76, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x69, 0x67,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x69, 0x67, 0x49, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x66, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x06, 0x66, 0x72, 0x65, 0x71, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x70, 0x72, 0x5f,
-	0x72, 0x65, 0x73, 0x5f, 0x6d, 0x65, 0x31, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
-	0x72, 0x52, 0x65, 0x73, 0x4d, 0x65, 0x31, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6e, 0x6f, 0x5f, 0x64,
-	0x62, 0x68, 0x7a, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6e, 0x6f, 0x44, 0x62,
-	0x68, 0x7a, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x6e,
-	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
-	0x49, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f, 0x72, 0x72, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6f, 0x6e, 0x6f, 0x5f, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x69, 0x6f, 0x6e, 0x6f, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x69, 0x67, 0x46, 0x6c, 0x61, 0x67, 0x73,
-	0x22, 0xb9, 0x07, 0x0a, 0x06, 0x4e, 0x61, 0x76, 0x50, 0x76, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x7a, 0x22, 0x32, 0x0a, 0x0c, 0x49, 0x6d, 0x75,
+	0x44, 0x61, 0x74, 0x61, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x07, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x49, 0x6d, 0x75,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x44, 0x0a,
+	0x15, 0x4d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2b, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x4d, 0x61, 0x67, 0x6e, 0x65, 0x74,
+	0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x73, 0x22, 0xe7, 0x03, 0x0a, 0x08, 0x47, 0x6e, 0x73, 0x73, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x35, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x5f, 0x65, 0x63, 0x73, 0x69, 0x67, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x47, 0x6e, 0x73, 0x73, 0x44, 0x61, 0x74, 0x61,
+	0x2e, 0x55, 0x62, 0x78, 0x53, 0x65, 0x63, 0x45, 0x63, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x09, 0x73,
+	0x65, 0x63, 0x45, 0x63, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x5f,
+	0x65, 0x63, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x45, 0x63, 0x73, 0x69, 0x67, 0x6e, 0x42, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x47, 0x6e, 0x73, 0x73,
+	0x44, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x0a, 0x61, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0xc6, 0x01, 0x0a, 0x0c,
+	0x55, 0x62, 0x78, 0x53, 0x65, 0x63, 0x45, 0x63, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x30, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x30, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4e, 0x75, 0x6d, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65,
+	0x63, 0x64, 0x73, 0x61, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x65, 0x63, 0x64, 0x73, 0x61, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x22, 0x57, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x41,
+	0x55, 0x54, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0xce, 0x01,
+	0x0a, 0x06, 0x4e, 0x61, 0x76, 0x44, 0x6f, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x6f,
+	0x77, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x74, 0x6f, 0x77,
+	0x4d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x64, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x67, 0x64, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x64, 0x6f, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x64, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x64,
+	0x6f, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x64, 0x6f, 0x70, 0x12, 0x12,
+	0x0a, 0x04, 0x76, 0x64, 0x6f, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x76, 0x64,
+	0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x64, 0x6f, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x68, 0x64, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x64, 0x6f, 0x70, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6e, 0x64, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x64,
+	0x6f, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x65, 0x64, 0x6f, 0x70, 0x22, 0xcf,
+	0x02, 0x0a, 0x06, 0x4e, 0x61, 0x76, 0x53, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74,
+	0x6f, 0x77, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x74, 0x6f,
+	0x77, 0x4d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a,
+	0x07, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x76, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x6e, 0x75, 0x6d, 0x53, 0x76, 0x73, 0x12, 0x1d, 0x0a, 0x03, 0x73, 0x76, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4e, 0x61, 0x76, 0x53, 0x61, 0x74, 0x2e, 0x53, 0x76, 0x73,
+	0x52, 0x03, 0x73, 0x76, 0x73, 0x1a, 0xb8, 0x01, 0x0a, 0x03, 0x53, 0x76, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x67, 0x6e, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x67, 0x6e, 0x73, 0x73, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x73, 0x76, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x76, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x6e, 0x6f, 0x5f, 0x64, 0x62, 0x68, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63,
+	0x6e, 0x6f, 0x44, 0x62, 0x68, 0x7a, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x76, 0x5f, 0x64,
+	0x65, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x76, 0x44, 0x65,
+	0x67, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x7a, 0x69, 0x6d, 0x5f, 0x64, 0x65, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x61, 0x7a, 0x69, 0x6d, 0x44, 0x65, 0x67, 0x12, 0x1c, 0x0a, 0x0a,
+	0x70, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x5f, 0x6d, 0x65, 0x31, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x72, 0x52, 0x65, 0x73, 0x4d, 0x65, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x22, 0xb7, 0x03, 0x0a, 0x06, 0x4e, 0x61, 0x76, 0x53, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
 	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07,
 	0x69, 0x74, 0x6f, 0x77, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69,
-	0x74, 0x6f, 0x77, 0x4d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f,
-	0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65,
-	0x4d, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x79, 0x65, 0x61, 0x72, 0x5f, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x05, 0x79, 0x65, 0x61, 0x72, 0x59, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x6e,
-	0x74, 0x68, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
-	0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x13, 0x0a, 0x05, 0x64, 0x61,
-	0x79, 0x5f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x64, 0x61, 0x79, 0x44, 0x12,
-	0x15, 0x0a, 0x06, 0x68, 0x6f, 0x75, 0x72, 0x5f, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x68, 0x6f, 0x75, 0x72, 0x48, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x69,
-	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x12,
-	0x13, 0x0a, 0x05, 0x73, 0x65, 0x63, 0x5f, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
-	0x73, 0x65, 0x63, 0x53, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x08, 0x74, 0x5f,
-	0x61, 0x63, 0x63, 0x5f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x41,
-	0x63, 0x63, 0x4e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6e, 0x6f, 0x5f, 0x6e, 0x73, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x61, 0x6e, 0x6f, 0x4e, 0x73, 0x12, 0x19, 0x0a,
-	0x08, 0x66, 0x69, 0x78, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x07, 0x66, 0x69, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67,
-	0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x32, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
-	0x66, 0x6c, 0x61, 0x67, 0x73, 0x32, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x76,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x75, 0x6d, 0x53, 0x76, 0x12, 0x1b, 0x0a,
-	0x09, 0x6c, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x67, 0x65, 0x37, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x6c, 0x6f, 0x6e, 0x44, 0x65, 0x67, 0x65, 0x37, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61,
-	0x74, 0x5f, 0x64, 0x65, 0x67, 0x65, 0x37, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c,
-	0x61, 0x74, 0x44, 0x65, 0x67, 0x65, 0x37, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x5f, 0x6d, 0x6d, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x4d, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6d, 0x73, 0x6c, 0x5f, 0x6d, 0x6d, 0x18,
-	0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x6d, 0x73, 0x6c, 0x4d, 0x6d, 0x12, 0x18, 0x0a,
-	0x08, 0x68, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x6d, 0x6d, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x06, 0x68, 0x41, 0x63, 0x63, 0x4d, 0x6d, 0x12, 0x18, 0x0a, 0x08, 0x76, 0x5f, 0x61, 0x63, 0x63,
-	0x5f, 0x6d, 0x6d, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x76, 0x41, 0x63, 0x63, 0x4d,
-	0x6d, 0x12, 0x1b, 0x0a, 0x0a, 0x76, 0x65, 0x6c, 0x5f, 0x6e, 0x5f, 0x6d, 0x6d, 0x5f, 0x73, 0x18,
-	0x17, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x6c, 0x4e, 0x4d, 0x6d, 0x53, 0x12, 0x1b,
-	0x0a, 0x0a, 0x76, 0x65, 0x6c, 0x5f, 0x65, 0x5f, 0x6d, 0x6d, 0x5f, 0x73, 0x18, 0x18, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x6c, 0x45, 0x4d, 0x6d, 0x53, 0x12, 0x1b, 0x0a, 0x0a, 0x76,
-	0x65, 0x6c, 0x5f, 0x64, 0x5f, 0x6d, 0x6d, 0x5f, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x07, 0x76, 0x65, 0x6c, 0x44, 0x4d, 0x6d, 0x53, 0x12, 0x1f, 0x0a, 0x0c, 0x67, 0x5f, 0x73, 0x70,
-	0x65, 0x65, 0x64, 0x5f, 0x6d, 0x6d, 0x5f, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
-	0x67, 0x53, 0x70, 0x65, 0x65, 0x64, 0x4d, 0x6d, 0x53, 0x12, 0x24, 0x0a, 0x0e, 0x68, 0x65, 0x61,
-	0x64, 0x5f, 0x6d, 0x6f, 0x74, 0x5f, 0x64, 0x65, 0x67, 0x65, 0x35, 0x18, 0x1b, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x64, 0x4d, 0x6f, 0x74, 0x44, 0x65, 0x67, 0x65, 0x35, 0x12,
-	0x1b, 0x0a, 0x0a, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x6d, 0x6d, 0x5f, 0x73, 0x18, 0x1c, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x41, 0x63, 0x63, 0x4d, 0x6d, 0x53, 0x12, 0x24, 0x0a, 0x0e,
-	0x68, 0x65, 0x61, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x64, 0x65, 0x67, 0x65, 0x35, 0x18, 0x1d,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x64, 0x41, 0x63, 0x63, 0x44, 0x65, 0x67,
-	0x65, 0x35, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x64, 0x6f, 0x70, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x04, 0x70, 0x64, 0x6f, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x33,
-	0x18, 0x1f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x33, 0x12, 0x24,
-	0x0a, 0x0e, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x76, 0x65, 0x68, 0x5f, 0x64, 0x65, 0x67, 0x65, 0x35,
-	0x18, 0x20, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x64, 0x56, 0x65, 0x68, 0x44,
-	0x65, 0x67, 0x65, 0x35, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x67, 0x5f, 0x64, 0x65, 0x63, 0x5f,
-	0x64, 0x65, 0x67, 0x65, 0x32, 0x18, 0x21, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x67,
-	0x44, 0x65, 0x63, 0x44, 0x65, 0x67, 0x65, 0x32, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x67, 0x5f,
-	0x61, 0x63, 0x63, 0x5f, 0x64, 0x65, 0x67, 0x65, 0x32, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0b, 0x6d, 0x61, 0x67, 0x41, 0x63, 0x63, 0x44, 0x65, 0x67, 0x65, 0x32, 0x22, 0xf7, 0x03, 0x0a,
-	0x06, 0x4e, 0x61, 0x76, 0x43, 0x6f, 0x76, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x6f, 0x77, 0x5f,
-	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x74, 0x6f, 0x77, 0x4d, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x6f,
-	0x73, 0x5f, 0x63, 0x6f, 0x76, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x43, 0x6f, 0x76, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x22,
-	0x0a, 0x0d, 0x76, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x76, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x76, 0x65, 0x6c, 0x43, 0x6f, 0x76, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x5f, 0x63, 0x6f, 0x76, 0x5f, 0x6e, 0x5f,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x43, 0x6f, 0x76, 0x4e,
-	0x4e, 0x12, 0x1d, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x5f, 0x63, 0x6f, 0x76, 0x5f, 0x6e, 0x5f, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x43, 0x6f, 0x76, 0x4e, 0x45,
-	0x12, 0x1d, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x5f, 0x63, 0x6f, 0x76, 0x5f, 0x6e, 0x5f, 0x64, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x43, 0x6f, 0x76, 0x4e, 0x44, 0x12,
-	0x1d, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x5f, 0x63, 0x6f, 0x76, 0x5f, 0x65, 0x5f, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x43, 0x6f, 0x76, 0x45, 0x45, 0x12, 0x1d,
-	0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x5f, 0x63, 0x6f, 0x76, 0x5f, 0x65, 0x5f, 0x64, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x43, 0x6f, 0x76, 0x45, 0x44, 0x12, 0x1d, 0x0a,
-	0x0b, 0x70, 0x6f, 0x73, 0x5f, 0x63, 0x6f, 0x76, 0x5f, 0x64, 0x5f, 0x64, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x43, 0x6f, 0x76, 0x44, 0x44, 0x12, 0x1d, 0x0a, 0x0b,
-	0x76, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x76, 0x5f, 0x6e, 0x5f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x43, 0x6f, 0x76, 0x4e, 0x4e, 0x12, 0x1d, 0x0a, 0x0b, 0x76,
-	0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x76, 0x5f, 0x6e, 0x5f, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x08, 0x76, 0x65, 0x6c, 0x43, 0x6f, 0x76, 0x4e, 0x45, 0x12, 0x1d, 0x0a, 0x0b, 0x76, 0x65,
-	0x6c, 0x5f, 0x63, 0x6f, 0x76, 0x5f, 0x6e, 0x5f, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x08, 0x76, 0x65, 0x6c, 0x43, 0x6f, 0x76, 0x4e, 0x44, 0x12, 0x1d, 0x0a, 0x0b, 0x76, 0x65, 0x6c,
-	0x5f, 0x63, 0x6f, 0x76, 0x5f, 0x65, 0x5f, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
-	0x76, 0x65, 0x6c, 0x43, 0x6f, 0x76, 0x45, 0x45, 0x12, 0x1d, 0x0a, 0x0b, 0x76, 0x65, 0x6c, 0x5f,
-	0x63, 0x6f, 0x76, 0x5f, 0x65, 0x5f, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76,
-	0x65, 0x6c, 0x43, 0x6f, 0x76, 0x45, 0x44, 0x12, 0x1d, 0x0a, 0x0b, 0x76, 0x65, 0x6c, 0x5f, 0x63,
-	0x6f, 0x76, 0x5f, 0x64, 0x5f, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x65,
-	0x6c, 0x43, 0x6f, 0x76, 0x44, 0x44, 0x22, 0x93, 0x01, 0x0a, 0x0a, 0x4e, 0x61, 0x76, 0x50, 0x6f,
-	0x73, 0x65, 0x63, 0x65, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x6f, 0x77, 0x5f, 0x6d, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x74, 0x6f, 0x77, 0x4d, 0x73, 0x12, 0x1a,
-	0x0a, 0x09, 0x65, 0x63, 0x65, 0x66, 0x5f, 0x78, 0x5f, 0x63, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x07, 0x65, 0x63, 0x65, 0x66, 0x58, 0x43, 0x6d, 0x12, 0x1a, 0x0a, 0x09, 0x65, 0x63,
-	0x65, 0x66, 0x5f, 0x79, 0x5f, 0x63, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65,
-	0x63, 0x65, 0x66, 0x59, 0x43, 0x6d, 0x12, 0x1a, 0x0a, 0x09, 0x65, 0x63, 0x65, 0x66, 0x5f, 0x7a,
-	0x5f, 0x63, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x63, 0x65, 0x66, 0x5a,
-	0x43, 0x6d, 0x12, 0x18, 0x0a, 0x08, 0x70, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x63, 0x6d, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x41, 0x63, 0x63, 0x43, 0x6d, 0x22, 0x99, 0x01, 0x0a,
-	0x0a, 0x4e, 0x61, 0x76, 0x54, 0x69, 0x6d, 0x65, 0x67, 0x70, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x69,
-	0x74, 0x6f, 0x77, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x74,
-	0x6f, 0x77, 0x4d, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x74, 0x6f, 0x77, 0x5f, 0x6e, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x74, 0x6f, 0x77, 0x4e, 0x73, 0x12, 0x12, 0x0a,
-	0x04, 0x77, 0x65, 0x65, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x65, 0x65,
-	0x6b, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x70, 0x5f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x6c, 0x65, 0x61, 0x70, 0x53, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x18,
-	0x0a, 0x08, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x74, 0x41, 0x63, 0x63, 0x4e, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x0a, 0x4e, 0x61, 0x76,
-	0x56, 0x65, 0x6c, 0x65, 0x63, 0x65, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x6f, 0x77, 0x5f,
-	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x74, 0x6f, 0x77, 0x4d, 0x73,
-	0x12, 0x1f, 0x0a, 0x0c, 0x65, 0x63, 0x65, 0x66, 0x5f, 0x76, 0x78, 0x5f, 0x63, 0x6d, 0x5f, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x63, 0x65, 0x66, 0x56, 0x78, 0x43, 0x6d,
-	0x53, 0x12, 0x1f, 0x0a, 0x0c, 0x65, 0x63, 0x65, 0x66, 0x5f, 0x76, 0x79, 0x5f, 0x63, 0x6d, 0x5f,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x63, 0x65, 0x66, 0x56, 0x79, 0x43,
-	0x6d, 0x53, 0x12, 0x1f, 0x0a, 0x0c, 0x65, 0x63, 0x65, 0x66, 0x5f, 0x76, 0x7a, 0x5f, 0x63, 0x6d,
-	0x5f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x63, 0x65, 0x66, 0x56, 0x7a,
-	0x43, 0x6d, 0x53, 0x12, 0x1b, 0x0a, 0x0a, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x63, 0x6d, 0x5f,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x41, 0x63, 0x63, 0x43, 0x6d, 0x53,
-	0x22, 0xae, 0x01, 0x0a, 0x09, 0x4e, 0x61, 0x76, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17,
-	0x0a, 0x07, 0x69, 0x74, 0x6f, 0x77, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x06, 0x69, 0x74, 0x6f, 0x77, 0x4d, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x70, 0x73, 0x5f, 0x66,
-	0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x67, 0x70, 0x73, 0x46, 0x69, 0x78,
-	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x78, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x69, 0x78, 0x53, 0x74, 0x61,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x06, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x74, 0x66,
-	0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x74, 0x66, 0x66, 0x12, 0x12, 0x0a,
-	0x04, 0x6d, 0x73, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x73, 0x73,
-	0x73, 0x22, 0xca, 0x03, 0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x52, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x2b, 0x0a, 0x09, 0x72, 0x66, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4d, 0x6f, 0x6e, 0x52, 0x66, 0x2e, 0x52, 0x46, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x08, 0x72, 0x66, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0xbf, 0x02, 0x0a,
-	0x07, 0x52, 0x46, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6e, 0x74,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61,
-	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x74, 0x5f,
-	0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x6e, 0x74,
-	0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x6f, 0x69, 0x73, 0x65, 0x5f,
-	0x70, 0x65, 0x72, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x6f,
-	0x69, 0x73, 0x65, 0x50, 0x65, 0x72, 0x4d, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x67, 0x63, 0x5f,
-	0x63, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x67, 0x63, 0x43, 0x6e,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x6a, 0x61, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x6a, 0x61, 0x6d, 0x49, 0x6e, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x6f, 0x66,
-	0x73, 0x5f, 0x69, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6f, 0x66, 0x73, 0x49, 0x12,
-	0x13, 0x0a, 0x05, 0x6d, 0x61, 0x67, 0x5f, 0x69, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
-	0x6d, 0x61, 0x67, 0x49, 0x12, 0x13, 0x0a, 0x05, 0x6f, 0x66, 0x73, 0x5f, 0x71, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x6f, 0x66, 0x73, 0x51, 0x12, 0x13, 0x0a, 0x05, 0x6d, 0x61, 0x67,
-	0x5f, 0x71, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x61, 0x67, 0x51, 0x22, 0xbd,
-	0x06, 0x0a, 0x08, 0x52, 0x78, 0x6d, 0x4d, 0x65, 0x61, 0x73, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x0a, 0x67, 0x70, 0x73, 0x5f, 0x74, 0x6f,
-	0x77, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x67, 0x70, 0x73, 0x54,
-	0x6f, 0x77, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x0a, 0x67, 0x6c, 0x6f, 0x5f, 0x74, 0x6f, 0x77, 0x5f,
-	0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x67, 0x6c, 0x6f, 0x54, 0x6f, 0x77,
-	0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x0a, 0x62, 0x64, 0x73, 0x5f, 0x74, 0x6f, 0x77, 0x5f, 0x6d, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x62, 0x64, 0x73, 0x54, 0x6f, 0x77, 0x4d, 0x73,
-	0x12, 0x1e, 0x0a, 0x0b, 0x71, 0x7a, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x77, 0x5f, 0x6d, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x71, 0x7a, 0x73, 0x73, 0x54, 0x6f, 0x77, 0x4d, 0x73,
-	0x12, 0x27, 0x0a, 0x10, 0x67, 0x70, 0x73, 0x5f, 0x74, 0x6f, 0x77, 0x5f, 0x61, 0x63, 0x63, 0x5f,
-	0x6d, 0x73, 0x6c, 0x34, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x67, 0x70, 0x73, 0x54,
-	0x6f, 0x77, 0x41, 0x63, 0x63, 0x4d, 0x73, 0x6c, 0x34, 0x12, 0x27, 0x0a, 0x10, 0x67, 0x6c, 0x6f,
-	0x5f, 0x74, 0x6f, 0x77, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x6d, 0x73, 0x6c, 0x34, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x67, 0x6c, 0x6f, 0x54, 0x6f, 0x77, 0x41, 0x63, 0x63, 0x4d, 0x73,
-	0x6c, 0x34, 0x12, 0x27, 0x0a, 0x10, 0x62, 0x64, 0x73, 0x5f, 0x74, 0x6f, 0x77, 0x5f, 0x61, 0x63,
-	0x63, 0x5f, 0x6d, 0x73, 0x6c, 0x34, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x62, 0x64,
-	0x73, 0x54, 0x6f, 0x77, 0x41, 0x63, 0x63, 0x4d, 0x73, 0x6c, 0x34, 0x12, 0x29, 0x0a, 0x11, 0x71,
-	0x7a, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x77, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x6d, 0x73, 0x6c, 0x34,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x71, 0x7a, 0x73, 0x73, 0x54, 0x6f, 0x77, 0x41,
-	0x63, 0x63, 0x4d, 0x73, 0x6c, 0x34, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x76,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x75, 0x6d, 0x53, 0x76, 0x12, 0x14, 0x0a,
-	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c,
-	0x61, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x02, 0x73, 0x76, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x52, 0x78, 0x6d, 0x4d, 0x65, 0x61, 0x73, 0x78, 0x2e, 0x52, 0x78, 0x6d, 0x4d, 0x65,
-	0x61, 0x73, 0x78, 0x53, 0x56, 0x54, 0x79, 0x70, 0x65, 0x52, 0x02, 0x73, 0x76, 0x1a, 0xfe, 0x02,
-	0x0a, 0x0e, 0x52, 0x78, 0x6d, 0x4d, 0x65, 0x61, 0x73, 0x78, 0x53, 0x56, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x17, 0x0a, 0x07, 0x67, 0x6e, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x06, 0x67, 0x6e, 0x73, 0x73, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x73, 0x76, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x76, 0x49, 0x64, 0x12, 0x11,
-	0x0a, 0x04, 0x63, 0x5f, 0x6e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x63, 0x4e,
-	0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x70, 0x61, 0x74, 0x68, 0x49, 0x6e, 0x64,
-	0x69, 0x63, 0x12, 0x23, 0x0a, 0x0e, 0x64, 0x6f, 0x70, 0x70, 0x6c, 0x65, 0x72, 0x5f, 0x6d, 0x73,
-	0x5f, 0x6d, 0x5f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x6f, 0x70, 0x70,
-	0x6c, 0x65, 0x72, 0x4d, 0x73, 0x4d, 0x53, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x6f, 0x70, 0x70, 0x6c,
-	0x65, 0x72, 0x5f, 0x68, 0x7a, 0x5f, 0x68, 0x7a, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
-	0x64, 0x6f, 0x70, 0x70, 0x6c, 0x65, 0x72, 0x48, 0x7a, 0x48, 0x7a, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
-	0x68, 0x6f, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x69, 0x70, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x77, 0x68, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x69, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
-	0x66, 0x72, 0x61, 0x63, 0x5f, 0x63, 0x68, 0x69, 0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x09, 0x66, 0x72, 0x61, 0x63, 0x43, 0x68, 0x69, 0x70, 0x73, 0x12, 0x29, 0x0a, 0x11, 0x63,
-	0x6f, 0x64, 0x65, 0x5f, 0x70, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x73, 0x6c, 0x5f, 0x32, 0x31,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x68, 0x61, 0x73,
-	0x65, 0x4d, 0x73, 0x6c, 0x32, 0x31, 0x12, 0x29, 0x0a, 0x11, 0x69, 0x6e, 0x74, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x5f, 0x70, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x50, 0x68, 0x61, 0x73, 0x65, 0x4d,
-	0x73, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x73, 0x65, 0x75, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f,
-	0x72, 0x6d, 0x73, 0x5f, 0x65, 0x72, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70,
-	0x73, 0x65, 0x75, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x6d, 0x73, 0x45, 0x72, 0x72, 0x22, 0x82,
-	0x05, 0x0a, 0x07, 0x52, 0x78, 0x6d, 0x52, 0x61, 0x77, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79,
+	0x74, 0x6f, 0x77, 0x4d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x19, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x69, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x53, 0x69, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x73, 0x69,
+	0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4e, 0x61, 0x76, 0x53, 0x69,
+	0x67, 0x2e, 0x53, 0x69, 0x67, 0x73, 0x52, 0x04, 0x73, 0x69, 0x67, 0x73, 0x1a, 0x9b, 0x02, 0x0a,
+	0x04, 0x53, 0x69, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x6e, 0x73, 0x73, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x67, 0x6e, 0x73, 0x73, 0x49, 0x64, 0x12, 0x13,
+	0x0a, 0x05, 0x73, 0x76, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73,
+	0x76, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x69, 0x67, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x72,
+	0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x72, 0x65,
+	0x71, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x70, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x5f, 0x6d, 0x65,
+	0x31, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x52, 0x65, 0x73, 0x4d, 0x65,
+	0x31, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6e, 0x6f, 0x5f, 0x64, 0x62, 0x68, 0x7a, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6e, 0x6f, 0x44, 0x62, 0x68, 0x7a, 0x12, 0x1f, 0x0a, 0x0b,
+	0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6f, 0x72, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f, 0x72, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x6f, 0x6e, 0x6f, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x69, 0x6f, 0x6e, 0x6f, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x73, 0x69, 0x67, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x22, 0xb9, 0x07, 0x0a, 0x06, 0x4e,
+	0x61, 0x76, 0x50, 0x76, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x6f, 0x77, 0x5f, 0x6d,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x74, 0x6f, 0x77, 0x4d, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x15, 0x0a, 0x06,
+	0x79, 0x65, 0x61, 0x72, 0x5f, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x79, 0x65,
+	0x61, 0x72, 0x59, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x5f, 0x6d, 0x6f, 0x6e,
+	0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x4d,
+	0x6f, 0x6e, 0x74, 0x68, 0x12, 0x13, 0x0a, 0x05, 0x64, 0x61, 0x79, 0x5f, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x64, 0x61, 0x79, 0x44, 0x12, 0x15, 0x0a, 0x06, 0x68, 0x6f, 0x75,
+	0x72, 0x5f, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x68, 0x6f, 0x75, 0x72, 0x48,
+	0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x12, 0x13, 0x0a, 0x05, 0x73, 0x65, 0x63,
+	0x5f, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x65, 0x63, 0x53, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x08, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x6e, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x41, 0x63, 0x63, 0x4e, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x61, 0x6e, 0x6f, 0x5f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x6e, 0x61, 0x6e, 0x6f, 0x4e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x78, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x69, 0x78, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x32, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x32,
+	0x12, 0x15, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x76, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x6e, 0x75, 0x6d, 0x53, 0x76, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x5f, 0x64,
+	0x65, 0x67, 0x65, 0x37, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x6e, 0x44,
+	0x65, 0x67, 0x65, 0x37, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x74, 0x5f, 0x64, 0x65, 0x67, 0x65,
+	0x37, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x44, 0x65, 0x67, 0x65,
+	0x37, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6d, 0x6d, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x6d, 0x12, 0x17,
+	0x0a, 0x07, 0x68, 0x6d, 0x73, 0x6c, 0x5f, 0x6d, 0x6d, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x68, 0x6d, 0x73, 0x6c, 0x4d, 0x6d, 0x12, 0x18, 0x0a, 0x08, 0x68, 0x5f, 0x61, 0x63, 0x63,
+	0x5f, 0x6d, 0x6d, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x41, 0x63, 0x63, 0x4d,
+	0x6d, 0x12, 0x18, 0x0a, 0x08, 0x76, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x6d, 0x6d, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x76, 0x41, 0x63, 0x63, 0x4d, 0x6d, 0x12, 0x1b, 0x0a, 0x0a, 0x76,
+	0x65, 0x6c, 0x5f, 0x6e, 0x5f, 0x6d, 0x6d, 0x5f, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x76, 0x65, 0x6c, 0x4e, 0x4d, 0x6d, 0x53, 0x12, 0x1b, 0x0a, 0x0a, 0x76, 0x65, 0x6c, 0x5f,
+	0x65, 0x5f, 0x6d, 0x6d, 0x5f, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65,
+	0x6c, 0x45, 0x4d, 0x6d, 0x53, 0x12, 0x1b, 0x0a, 0x0a, 0x76, 0x65, 0x6c, 0x5f, 0x64, 0x5f, 0x6d,
+	0x6d, 0x5f, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x6c, 0x44, 0x4d,
+	0x6d, 0x53, 0x12, 0x1f, 0x0a, 0x0c, 0x67, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x6d, 0x6d,
+	0x5f, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x67, 0x53, 0x70, 0x65, 0x65, 0x64,
+	0x4d, 0x6d, 0x53, 0x12, 0x24, 0x0a, 0x0e, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x6f, 0x74, 0x5f,
+	0x64, 0x65, 0x67, 0x65, 0x35, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x68, 0x65, 0x61,
+	0x64, 0x4d, 0x6f, 0x74, 0x44, 0x65, 0x67, 0x65, 0x35, 0x12, 0x1b, 0x0a, 0x0a, 0x73, 0x5f, 0x61,
+	0x63, 0x63, 0x5f, 0x6d, 0x6d, 0x5f, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73,
+	0x41, 0x63, 0x63, 0x4d, 0x6d, 0x53, 0x12, 0x24, 0x0a, 0x0e, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x61,
+	0x63, 0x63, 0x5f, 0x64, 0x65, 0x67, 0x65, 0x35, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x68, 0x65, 0x61, 0x64, 0x41, 0x63, 0x63, 0x44, 0x65, 0x67, 0x65, 0x35, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x64, 0x6f, 0x70, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x64, 0x6f, 0x70,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x33, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x33, 0x12, 0x24, 0x0a, 0x0e, 0x68, 0x65, 0x61, 0x64,
+	0x5f, 0x76, 0x65, 0x68, 0x5f, 0x64, 0x65, 0x67, 0x65, 0x35, 0x18, 0x20, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x68, 0x65, 0x61, 0x64, 0x56, 0x65, 0x68, 0x44, 0x65, 0x67, 0x65, 0x35, 0x12, 0x22,
+	0x0a, 0x0d, 0x6d, 0x61, 0x67, 0x5f, 0x64, 0x65, 0x63, 0x5f, 0x64, 0x65, 0x67, 0x65, 0x32, 0x18,
+	0x21, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x67, 0x44, 0x65, 0x63, 0x44, 0x65, 0x67,
+	0x65, 0x32, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x67, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x64, 0x65,
+	0x67, 0x65, 0x32, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x67, 0x41, 0x63,
+	0x63, 0x44, 0x65, 0x67, 0x65, 0x32, 0x22, 0xf7, 0x03, 0x0a, 0x06, 0x4e, 0x61, 0x76, 0x43, 0x6f,
+	0x76, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x6f, 0x77, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x69, 0x74, 0x6f, 0x77, 0x4d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x6f, 0x73, 0x5f, 0x63, 0x6f, 0x76, 0x5f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x6f, 0x73,
+	0x43, 0x6f, 0x76, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x76, 0x65, 0x6c, 0x5f,
+	0x63, 0x6f, 0x76, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x76, 0x65, 0x6c, 0x43, 0x6f, 0x76, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0b,
+	0x70, 0x6f, 0x73, 0x5f, 0x63, 0x6f, 0x76, 0x5f, 0x6e, 0x5f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x43, 0x6f, 0x76, 0x4e, 0x4e, 0x12, 0x1d, 0x0a, 0x0b, 0x70,
+	0x6f, 0x73, 0x5f, 0x63, 0x6f, 0x76, 0x5f, 0x6e, 0x5f, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x43, 0x6f, 0x76, 0x4e, 0x45, 0x12, 0x1d, 0x0a, 0x0b, 0x70, 0x6f,
+	0x73, 0x5f, 0x63, 0x6f, 0x76, 0x5f, 0x6e, 0x5f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x43, 0x6f, 0x76, 0x4e, 0x44, 0x12, 0x1d, 0x0a, 0x0b, 0x70, 0x6f, 0x73,
+	0x5f, 0x63, 0x6f, 0x76, 0x5f, 0x65, 0x5f, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x70, 0x6f, 0x73, 0x43, 0x6f, 0x76, 0x45, 0x45, 0x12, 0x1d, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x5f,
+	0x63, 0x6f, 0x76, 0x5f, 0x65, 0x5f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x43, 0x6f, 0x76, 0x45, 0x44, 0x12, 0x1d, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x5f, 0x63,
+	0x6f, 0x76, 0x5f, 0x64, 0x5f, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x6f,
+	0x73, 0x43, 0x6f, 0x76, 0x44, 0x44, 0x12, 0x1d, 0x0a, 0x0b, 0x76, 0x65, 0x6c, 0x5f, 0x63, 0x6f,
+	0x76, 0x5f, 0x6e, 0x5f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x65, 0x6c,
+	0x43, 0x6f, 0x76, 0x4e, 0x4e, 0x12, 0x1d, 0x0a, 0x0b, 0x76, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x76,
+	0x5f, 0x6e, 0x5f, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x43,
+	0x6f, 0x76, 0x4e, 0x45, 0x12, 0x1d, 0x0a, 0x0b, 0x76, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x76, 0x5f,
+	0x6e, 0x5f, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x43, 0x6f,
+	0x76, 0x4e, 0x44, 0x12, 0x1d, 0x0a, 0x0b, 0x76, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x76, 0x5f, 0x65,
+	0x5f, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x43, 0x6f, 0x76,
+	0x45, 0x45, 0x12, 0x1d, 0x0a, 0x0b, 0x76, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x76, 0x5f, 0x65, 0x5f,
+	0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x43, 0x6f, 0x76, 0x45,
+	0x44, 0x12, 0x1d, 0x0a, 0x0b, 0x76, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x76, 0x5f, 0x64, 0x5f, 0x64,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x43, 0x6f, 0x76, 0x44, 0x44,
+	0x22, 0x93, 0x01, 0x0a, 0x0a, 0x4e, 0x61, 0x76, 0x50, 0x6f, 0x73, 0x65, 0x63, 0x65, 0x66, 0x12,
+	0x17, 0x0a, 0x07, 0x69, 0x74, 0x6f, 0x77, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x69, 0x74, 0x6f, 0x77, 0x4d, 0x73, 0x12, 0x1a, 0x0a, 0x09, 0x65, 0x63, 0x65, 0x66,
+	0x5f, 0x78, 0x5f, 0x63, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x63, 0x65,
+	0x66, 0x58, 0x43, 0x6d, 0x12, 0x1a, 0x0a, 0x09, 0x65, 0x63, 0x65, 0x66, 0x5f, 0x79, 0x5f, 0x63,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x63, 0x65, 0x66, 0x59, 0x43, 0x6d,
+	0x12, 0x1a, 0x0a, 0x09, 0x65, 0x63, 0x65, 0x66, 0x5f, 0x7a, 0x5f, 0x63, 0x6d, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x63, 0x65, 0x66, 0x5a, 0x43, 0x6d, 0x12, 0x18, 0x0a, 0x08,
+	0x70, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x63, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x70, 0x41, 0x63, 0x63, 0x43, 0x6d, 0x22, 0x99, 0x01, 0x0a, 0x0a, 0x4e, 0x61, 0x76, 0x54, 0x69,
+	0x6d, 0x65, 0x67, 0x70, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x6f, 0x77, 0x5f, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x74, 0x6f, 0x77, 0x4d, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x66, 0x74, 0x6f, 0x77, 0x5f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x66, 0x74, 0x6f, 0x77, 0x4e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x65, 0x65, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x65, 0x65, 0x6b, 0x12, 0x15, 0x0a, 0x06, 0x6c,
+	0x65, 0x61, 0x70, 0x5f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x61,
+	0x70, 0x53, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x08, 0x74, 0x5f, 0x61, 0x63,
+	0x63, 0x5f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x41, 0x63, 0x63,
+	0x4e, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x0a, 0x4e, 0x61, 0x76, 0x56, 0x65, 0x6c, 0x65, 0x63, 0x65,
+	0x66, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x6f, 0x77, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x69, 0x74, 0x6f, 0x77, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0c, 0x65, 0x63,
+	0x65, 0x66, 0x5f, 0x76, 0x78, 0x5f, 0x63, 0x6d, 0x5f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x65, 0x63, 0x65, 0x66, 0x56, 0x78, 0x43, 0x6d, 0x53, 0x12, 0x1f, 0x0a, 0x0c, 0x65,
+	0x63, 0x65, 0x66, 0x5f, 0x76, 0x79, 0x5f, 0x63, 0x6d, 0x5f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x65, 0x63, 0x65, 0x66, 0x56, 0x79, 0x43, 0x6d, 0x53, 0x12, 0x1f, 0x0a, 0x0c,
+	0x65, 0x63, 0x65, 0x66, 0x5f, 0x76, 0x7a, 0x5f, 0x63, 0x6d, 0x5f, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x65, 0x63, 0x65, 0x66, 0x56, 0x7a, 0x43, 0x6d, 0x53, 0x12, 0x1b, 0x0a,
+	0x0a, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x63, 0x6d, 0x5f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x73, 0x41, 0x63, 0x63, 0x43, 0x6d, 0x53, 0x22, 0xae, 0x01, 0x0a, 0x09, 0x4e,
+	0x61, 0x76, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x6f, 0x77,
+	0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x74, 0x6f, 0x77, 0x4d,
+	0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x70, 0x73, 0x5f, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x67, 0x70, 0x73, 0x46, 0x69, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x66, 0x69, 0x78, 0x53, 0x74, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x74, 0x66, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x74, 0x74, 0x66, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x73, 0x73, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x73, 0x73, 0x73, 0x22, 0xca, 0x03, 0x0a, 0x05,
+	0x4d, 0x6f, 0x6e, 0x52, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2b, 0x0a, 0x09, 0x72, 0x66, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x52, 0x66, 0x2e, 0x52, 0x46, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x08, 0x72, 0x66,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0xbf, 0x02, 0x0a, 0x07, 0x52, 0x46, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x6e, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x6f, 0x69, 0x73, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x6f, 0x69, 0x73, 0x65, 0x50, 0x65, 0x72,
+	0x4d, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x67, 0x63, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x67, 0x63, 0x43, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6a,
+	0x61, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6a, 0x61,
+	0x6d, 0x49, 0x6e, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x6f, 0x66, 0x73, 0x5f, 0x69, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x6f, 0x66, 0x73, 0x49, 0x12, 0x13, 0x0a, 0x05, 0x6d, 0x61, 0x67,
+	0x5f, 0x69, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x61, 0x67, 0x49, 0x12, 0x13,
+	0x0a, 0x05, 0x6f, 0x66, 0x73, 0x5f, 0x71, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6f,
+	0x66, 0x73, 0x51, 0x12, 0x13, 0x0a, 0x05, 0x6d, 0x61, 0x67, 0x5f, 0x71, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x6d, 0x61, 0x67, 0x51, 0x22, 0xbd, 0x06, 0x0a, 0x08, 0x52, 0x78, 0x6d,
+	0x4d, 0x65, 0x61, 0x73, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x0a, 0x67, 0x70, 0x73, 0x5f, 0x74, 0x6f, 0x77, 0x5f, 0x6d, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x67, 0x70, 0x73, 0x54, 0x6f, 0x77, 0x4d, 0x73, 0x12, 0x1c,
+	0x0a, 0x0a, 0x67, 0x6c, 0x6f, 0x5f, 0x74, 0x6f, 0x77, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x67, 0x6c, 0x6f, 0x54, 0x6f, 0x77, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x0a,
+	0x62, 0x64, 0x73, 0x5f, 0x74, 0x6f, 0x77, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x62, 0x64, 0x73, 0x54, 0x6f, 0x77, 0x4d, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x71, 0x7a,
+	0x73, 0x73, 0x5f, 0x74, 0x6f, 0x77, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x71, 0x7a, 0x73, 0x73, 0x54, 0x6f, 0x77, 0x4d, 0x73, 0x12, 0x27, 0x0a, 0x10, 0x67, 0x70,
+	0x73, 0x5f, 0x74, 0x6f, 0x77, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x6d, 0x73, 0x6c, 0x34, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x67, 0x70, 0x73, 0x54, 0x6f, 0x77, 0x41, 0x63, 0x63, 0x4d,
+	0x73, 0x6c, 0x34, 0x12, 0x27, 0x0a, 0x10, 0x67, 0x6c, 0x6f, 0x5f, 0x74, 0x6f, 0x77, 0x5f, 0x61,
+	0x63, 0x63, 0x5f, 0x6d, 0x73, 0x6c, 0x34, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x67,
+	0x6c, 0x6f, 0x54, 0x6f, 0x77, 0x41, 0x63, 0x63, 0x4d, 0x73, 0x6c, 0x34, 0x12, 0x27, 0x0a, 0x10,
+	0x62, 0x64, 0x73, 0x5f, 0x74, 0x6f, 0x77, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x6d, 0x73, 0x6c, 0x34,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x62, 0x64, 0x73, 0x54, 0x6f, 0x77, 0x41, 0x63,
+	0x63, 0x4d, 0x73, 0x6c, 0x34, 0x12, 0x29, 0x0a, 0x11, 0x71, 0x7a, 0x73, 0x73, 0x5f, 0x74, 0x6f,
+	0x77, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x6d, 0x73, 0x6c, 0x34, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0e, 0x71, 0x7a, 0x73, 0x73, 0x54, 0x6f, 0x77, 0x41, 0x63, 0x63, 0x4d, 0x73, 0x6c, 0x34,
+	0x12, 0x15, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x76, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x6e, 0x75, 0x6d, 0x53, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x28, 0x0a,
+	0x02, 0x73, 0x76, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x52, 0x78, 0x6d, 0x4d,
+	0x65, 0x61, 0x73, 0x78, 0x2e, 0x52, 0x78, 0x6d, 0x4d, 0x65, 0x61, 0x73, 0x78, 0x53, 0x56, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x02, 0x73, 0x76, 0x1a, 0xfe, 0x02, 0x0a, 0x0e, 0x52, 0x78, 0x6d, 0x4d,
+	0x65, 0x61, 0x73, 0x78, 0x53, 0x56, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x6e,
+	0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x67, 0x6e, 0x73,
+	0x73, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x73, 0x76, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x73, 0x76, 0x49, 0x64, 0x12, 0x11, 0x0a, 0x04, 0x63, 0x5f, 0x6e, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x63, 0x4e, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x70, 0x61, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x6d, 0x70, 0x61, 0x74, 0x68, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x12, 0x23, 0x0a, 0x0e,
+	0x64, 0x6f, 0x70, 0x70, 0x6c, 0x65, 0x72, 0x5f, 0x6d, 0x73, 0x5f, 0x6d, 0x5f, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x6f, 0x70, 0x70, 0x6c, 0x65, 0x72, 0x4d, 0x73, 0x4d,
+	0x53, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x6f, 0x70, 0x70, 0x6c, 0x65, 0x72, 0x5f, 0x68, 0x7a, 0x5f,
+	0x68, 0x7a, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x6f, 0x70, 0x70, 0x6c, 0x65,
+	0x72, 0x48, 0x7a, 0x48, 0x7a, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x68, 0x6f, 0x6c, 0x65, 0x5f, 0x63,
+	0x68, 0x69, 0x70, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x77, 0x68, 0x6f, 0x6c,
+	0x65, 0x43, 0x68, 0x69, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x61, 0x63, 0x5f, 0x63,
+	0x68, 0x69, 0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x66, 0x72, 0x61, 0x63,
+	0x43, 0x68, 0x69, 0x70, 0x73, 0x12, 0x29, 0x0a, 0x11, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x68,
+	0x61, 0x73, 0x65, 0x5f, 0x6d, 0x73, 0x6c, 0x5f, 0x32, 0x31, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0e, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x68, 0x61, 0x73, 0x65, 0x4d, 0x73, 0x6c, 0x32, 0x31,
+	0x12, 0x29, 0x0a, 0x11, 0x69, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x68, 0x61,
+	0x73, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x69, 0x6e, 0x74,
+	0x43, 0x6f, 0x64, 0x65, 0x50, 0x68, 0x61, 0x73, 0x65, 0x4d, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x70,
+	0x73, 0x65, 0x75, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x6d, 0x73, 0x5f, 0x65, 0x72,
+	0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x73, 0x65, 0x75, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x6d, 0x73, 0x45, 0x72, 0x72, 0x22, 0x82, 0x05, 0x0a, 0x07, 0x52, 0x78, 0x6d,
+	0x52, 0x61, 0x77, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x09, 0x72, 0x63, 0x76, 0x5f, 0x74, 0x6f, 0x77,
+	0x5f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x72, 0x63, 0x76, 0x54, 0x6f, 0x77,
+	0x53, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x65, 0x65, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x77, 0x65, 0x65, 0x6b, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x70, 0x5f, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x65, 0x61, 0x70, 0x53, 0x12, 0x19, 0x0a, 0x08,
+	0x6e, 0x75, 0x6d, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x6e, 0x75, 0x6d, 0x4d, 0x65, 0x61, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x63, 0x53, 0x74,
+	0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x04,
+	0x6d, 0x65, 0x61, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x52, 0x78, 0x6d,
+	0x52, 0x61, 0x77, 0x78, 0x2e, 0x52, 0x78, 0x6d, 0x52, 0x61, 0x77, 0x78, 0x4d, 0x65, 0x61, 0x73,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x73, 0x1a, 0x90, 0x03, 0x0a, 0x0f, 0x52,
+	0x78, 0x6d, 0x52, 0x61, 0x77, 0x78, 0x4d, 0x65, 0x61, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15,
+	0x0a, 0x06, 0x70, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x70, 0x72, 0x4d, 0x65, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x70, 0x5f, 0x6d, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x63, 0x70, 0x4d, 0x65, 0x73, 0x12, 0x15, 0x0a, 0x06,
+	0x64, 0x6f, 0x5f, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x6f,
+	0x4d, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x6e, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x67, 0x6e, 0x73, 0x73, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05,
+	0x73, 0x76, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x76, 0x49,
+	0x64, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x73, 0x69, 0x67, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x72, 0x65, 0x71,
+	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x72, 0x65, 0x71, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65,
+	0x4d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6e, 0x6f, 0x5f, 0x64, 0x62, 0x68, 0x7a, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6e, 0x6f, 0x44, 0x62, 0x68, 0x7a, 0x12, 0x28, 0x0a,
+	0x11, 0x70, 0x72, 0x5f, 0x73, 0x74, 0x64, 0x65, 0x76, 0x5f, 0x6d, 0x5f, 0x31, 0x65, 0x32, 0x5f,
+	0x32, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x70, 0x72, 0x53, 0x74, 0x64, 0x65,
+	0x76, 0x4d, 0x31, 0x65, 0x32, 0x32, 0x6e, 0x12, 0x2d, 0x0a, 0x13, 0x63, 0x70, 0x5f, 0x73, 0x74,
+	0x64, 0x65, 0x76, 0x5f, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x5f, 0x34, 0x65, 0x33, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x63, 0x70, 0x53, 0x74, 0x64, 0x65, 0x76, 0x43, 0x79, 0x63,
+	0x6c, 0x65, 0x73, 0x34, 0x65, 0x33, 0x12, 0x2a, 0x0a, 0x12, 0x64, 0x6f, 0x5f, 0x73, 0x74, 0x64,
+	0x65, 0x76, 0x5f, 0x68, 0x7a, 0x5f, 0x32, 0x65, 0x33, 0x5f, 0x32, 0x6e, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x64, 0x6f, 0x53, 0x74, 0x64, 0x65, 0x76, 0x48, 0x7a, 0x32, 0x65, 0x33,
+	0x32, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x22, 0xa7, 0x02,
+	0x0a, 0x08, 0x52, 0x78, 0x6d, 0x53, 0x66, 0x72, 0x62, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79,
 	0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x09, 0x72,
-	0x63, 0x76, 0x5f, 0x74, 0x6f, 0x77, 0x5f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
-	0x72, 0x63, 0x76, 0x54, 0x6f, 0x77, 0x53, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x65, 0x65, 0x6b, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x77, 0x65, 0x65, 0x6b, 0x12, 0x15, 0x0a, 0x06, 0x6c,
-	0x65, 0x61, 0x70, 0x5f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x65, 0x61,
-	0x70, 0x53, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x4d, 0x65, 0x61, 0x73, 0x12, 0x19, 0x0a,
-	0x08, 0x72, 0x65, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x07, 0x72, 0x65, 0x63, 0x53, 0x74, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x52, 0x78, 0x6d, 0x52, 0x61, 0x77, 0x78, 0x2e, 0x52, 0x78, 0x6d, 0x52, 0x61,
-	0x77, 0x78, 0x4d, 0x65, 0x61, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x73,
-	0x1a, 0x90, 0x03, 0x0a, 0x0f, 0x52, 0x78, 0x6d, 0x52, 0x61, 0x77, 0x78, 0x4d, 0x65, 0x61, 0x73,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x4d, 0x65, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x63,
-	0x70, 0x5f, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x63, 0x70, 0x4d,
-	0x65, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x6f, 0x5f, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x05, 0x64, 0x6f, 0x4d, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x6e, 0x73,
-	0x73, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x67, 0x6e, 0x73, 0x73,
-	0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x73, 0x76, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x04, 0x73, 0x76, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x5f, 0x69,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x69, 0x67, 0x49, 0x64, 0x12, 0x17,
-	0x0a, 0x07, 0x66, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x06, 0x66, 0x72, 0x65, 0x71, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x74,
-	0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f,
-	0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6e, 0x6f, 0x5f,
-	0x64, 0x62, 0x68, 0x7a, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6e, 0x6f, 0x44,
-	0x62, 0x68, 0x7a, 0x12, 0x28, 0x0a, 0x11, 0x70, 0x72, 0x5f, 0x73, 0x74, 0x64, 0x65, 0x76, 0x5f,
-	0x6d, 0x5f, 0x31, 0x65, 0x32, 0x5f, 0x32, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
-	0x70, 0x72, 0x53, 0x74, 0x64, 0x65, 0x76, 0x4d, 0x31, 0x65, 0x32, 0x32, 0x6e, 0x12, 0x2d, 0x0a,
-	0x13, 0x63, 0x70, 0x5f, 0x73, 0x74, 0x64, 0x65, 0x76, 0x5f, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73,
-	0x5f, 0x34, 0x65, 0x33, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x63, 0x70, 0x53, 0x74,
-	0x64, 0x65, 0x76, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x34, 0x65, 0x33, 0x12, 0x2a, 0x0a, 0x12,
-	0x64, 0x6f, 0x5f, 0x73, 0x74, 0x64, 0x65, 0x76, 0x5f, 0x68, 0x7a, 0x5f, 0x32, 0x65, 0x33, 0x5f,
-	0x32, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x64, 0x6f, 0x53, 0x74, 0x64, 0x65,
-	0x76, 0x48, 0x7a, 0x32, 0x65, 0x33, 0x32, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x6b, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x72, 0x6b, 0x53,
-	0x74, 0x61, 0x74, 0x22, 0xa7, 0x02, 0x0a, 0x08, 0x52, 0x78, 0x6d, 0x53, 0x66, 0x72, 0x62, 0x78,
-	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x6e, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x06, 0x67, 0x6e, 0x73, 0x73, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x73, 0x76,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x76, 0x49, 0x64, 0x12,
-	0x15, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x73, 0x69, 0x67, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x72, 0x65, 0x71, 0x5f, 0x69,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x72, 0x65, 0x71, 0x49, 0x64, 0x12,
-	0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x10, 0x0a, 0x03,
-	0x63, 0x68, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x63, 0x68, 0x6e, 0x12, 0x18,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x64,
-	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52,
-	0x78, 0x6d, 0x53, 0x66, 0x72, 0x62, 0x78, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x1a, 0x1f, 0x0a, 0x09,
-	0x57, 0x6f, 0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x77, 0x72,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x64, 0x77, 0x72, 0x64, 0x22, 0xc0, 0x01,
-	0x0a, 0x05, 0x54, 0x69, 0x6d, 0x54, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x6f, 0x77,
-	0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x74, 0x6f, 0x77, 0x4d,
-	0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x69, 0x74, 0x6f, 0x77, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x6d, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x69, 0x74, 0x6f, 0x77, 0x53, 0x75, 0x62, 0x4d,
-	0x73, 0x12, 0x18, 0x0a, 0x08, 0x71, 0x5f, 0x65, 0x72, 0x72, 0x5f, 0x70, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x71, 0x45, 0x72, 0x72, 0x50, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77,
-	0x65, 0x65, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x77, 0x65, 0x65, 0x6b, 0x12,
-	0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x6e, 0x66,
-	0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x66, 0x49, 0x6e, 0x66, 0x6f,
-	0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x3b, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67,
+	0x6e, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x67, 0x6e,
+	0x73, 0x73, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x73, 0x76, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x76, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x69, 0x67,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x69, 0x67, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x66, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x66, 0x72, 0x65, 0x71, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d,
+	0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75,
+	0x6d, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x68, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x63, 0x68, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52, 0x78, 0x6d, 0x53, 0x66, 0x72, 0x62,
+	0x78, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x09, 0x77, 0x6f, 0x72,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x1a, 0x1f, 0x0a, 0x09, 0x57, 0x6f, 0x72, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x77, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x64, 0x77, 0x72, 0x64, 0x22, 0xc0, 0x01, 0x0a, 0x05, 0x54, 0x69, 0x6d, 0x54,
+	0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x6f, 0x77, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x74, 0x6f, 0x77, 0x4d, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x69,
+	0x74, 0x6f, 0x77, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x69, 0x74, 0x6f, 0x77, 0x53, 0x75, 0x62, 0x4d, 0x73, 0x12, 0x18, 0x0a, 0x08, 0x71,
+	0x5f, 0x65, 0x72, 0x72, 0x5f, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x71,
+	0x45, 0x72, 0x72, 0x50, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x65, 0x65, 0x6b, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x77, 0x65, 0x65, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12,
+	0x19, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x72, 0x65, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xce, 0x03, 0x0a, 0x09, 0x47,
+	0x6e, 0x73, 0x73, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x6f,
+	0x77, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x74, 0x6f, 0x77,
+	0x4d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x20,
+	0x0a, 0x07, 0x6e, 0x61, 0x76, 0x5f, 0x70, 0x76, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x07, 0x2e, 0x4e, 0x61, 0x76, 0x50, 0x76, 0x74, 0x52, 0x06, 0x6e, 0x61, 0x76, 0x50, 0x76, 0x74,
+	0x12, 0x20, 0x0a, 0x07, 0x6e, 0x61, 0x76, 0x5f, 0x63, 0x6f, 0x76, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x07, 0x2e, 0x4e, 0x61, 0x76, 0x43, 0x6f, 0x76, 0x52, 0x06, 0x6e, 0x61, 0x76, 0x43,
+	0x6f, 0x76, 0x12, 0x20, 0x0a, 0x07, 0x6e, 0x61, 0x76, 0x5f, 0x64, 0x6f, 0x70, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4e, 0x61, 0x76, 0x44, 0x6f, 0x70, 0x52, 0x06, 0x6e, 0x61,
+	0x76, 0x44, 0x6f, 0x70, 0x12, 0x2c, 0x0a, 0x0b, 0x6e, 0x61, 0x76, 0x5f, 0x70, 0x6f, 0x73, 0x65,
+	0x63, 0x65, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4e, 0x61, 0x76, 0x50,
+	0x6f, 0x73, 0x65, 0x63, 0x65, 0x66, 0x52, 0x0a, 0x6e, 0x61, 0x76, 0x50, 0x6f, 0x73, 0x65, 0x63,
+	0x65, 0x66, 0x12, 0x2c, 0x0a, 0x0b, 0x6e, 0x61, 0x76, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x67, 0x70,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4e, 0x61, 0x76, 0x54, 0x69, 0x6d,
+	0x65, 0x67, 0x70, 0x73, 0x52, 0x0a, 0x6e, 0x61, 0x76, 0x54, 0x69, 0x6d, 0x65, 0x67, 0x70, 0x73,
+	0x12, 0x2c, 0x0a, 0x0b, 0x6e, 0x61, 0x76, 0x5f, 0x76, 0x65, 0x6c, 0x65, 0x63, 0x65, 0x66, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4e, 0x61, 0x76, 0x56, 0x65, 0x6c, 0x65, 0x63,
+	0x65, 0x66, 0x52, 0x0a, 0x6e, 0x61, 0x76, 0x56, 0x65, 0x6c, 0x65, 0x63, 0x65, 0x66, 0x12, 0x29,
+	0x0a, 0x0a, 0x6e, 0x61, 0x76, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4e, 0x61, 0x76, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x09,
+	0x6e, 0x61, 0x76, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x07, 0x6e, 0x61, 0x76,
+	0x5f, 0x73, 0x69, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4e, 0x61, 0x76,
+	0x53, 0x69, 0x67, 0x52, 0x06, 0x6e, 0x61, 0x76, 0x53, 0x69, 0x67, 0x22, 0x80, 0x01, 0x0a, 0x0c,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24,
+	0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x54, 0x69,
+	0x6d, 0x65, 0x4e, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x4e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0e,
+	0x5a, 0x0c, 0x2e, 0x3b, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3207,52 +3638,69 @@ func file_sensordata_proto_rawDescGZIP() []byte {
 	return file_sensordata_proto_rawDescData
 }
 
-var file_sensordata_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_sensordata_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_sensordata_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_sensordata_proto_goTypes = []interface{}{
-	(*ImuData)(nil),                   // 0: ImuData
-	(*MagnetometerData)(nil),          // 1: MagnetometerData
-	(*GnssData)(nil),                  // 2: GnssData
-	(*NavDop)(nil),                    // 3: NavDop
-	(*NavSat)(nil),                    // 4: NavSat
-	(*NavSig)(nil),                    // 5: NavSig
-	(*NavPvt)(nil),                    // 6: NavPvt
-	(*NavCov)(nil),                    // 7: NavCov
-	(*NavPosecef)(nil),                // 8: NavPosecef
-	(*NavTimegps)(nil),                // 9: NavTimegps
-	(*NavVelecef)(nil),                // 10: NavVelecef
-	(*NavStatus)(nil),                 // 11: NavStatus
-	(*MonRf)(nil),                     // 12: MonRf
-	(*RxmMeasx)(nil),                  // 13: RxmMeasx
-	(*RxmRawx)(nil),                   // 14: RxmRawx
-	(*RxmSfrbx)(nil),                  // 15: RxmSfrbx
-	(*TimTp)(nil),                     // 16: TimTp
-	(*ImuData_AccelerometerData)(nil), // 17: ImuData.AccelerometerData
-	(*ImuData_GyroscopeData)(nil),     // 18: ImuData.GyroscopeData
-	(*ImuData_FsyncData)(nil),         // 19: ImuData.FsyncData
-	(*GnssData_UbxSecEcsign)(nil),     // 20: GnssData.UbxSecEcsign
-	(*NavSat_Svs)(nil),                // 21: NavSat.Svs
-	(*NavSig_Sigs)(nil),               // 22: NavSig.Sigs
-	(*MonRf_RFBlock)(nil),             // 23: MonRf.RFBlock
-	(*RxmMeasx_RxmMeasxSVType)(nil),   // 24: RxmMeasx.RxmMeasxSVType
-	(*RxmRawx_RxmRawxMeasType)(nil),   // 25: RxmRawx.RxmRawxMeasType
-	(*RxmSfrbx_WordBlock)(nil),        // 26: RxmSfrbx.WordBlock
+	(GnssData_AuthStatus)(0),          // 0: GnssData.AuthStatus
+	(*ImuData)(nil),                   // 1: ImuData
+	(*MagnetometerData)(nil),          // 2: MagnetometerData
+	(*ImuDataBatch)(nil),              // 3: ImuDataBatch
+	(*MagnetometerDataBatch)(nil),     // 4: MagnetometerDataBatch
+	(*GnssData)(nil),                  // 5: GnssData
+	(*NavDop)(nil),                    // 6: NavDop
+	(*NavSat)(nil),                    // 7: NavSat
+	(*NavSig)(nil),                    // 8: NavSig
+	(*NavPvt)(nil),                    // 9: NavPvt
+	(*NavCov)(nil),                    // 10: NavCov
+	(*NavPosecef)(nil),                // 11: NavPosecef
+	(*NavTimegps)(nil),                // 12: NavTimegps
+	(*NavVelecef)(nil),                // 13: NavVelecef
+	(*NavStatus)(nil),                 // 14: NavStatus
+	(*MonRf)(nil),                     // 15: MonRf
+	(*RxmMeasx)(nil),                  // 16: RxmMeasx
+	(*RxmRawx)(nil),                   // 17: RxmRawx
+	(*RxmSfrbx)(nil),                  // 18: RxmSfrbx
+	(*TimTp)(nil),                     // 19: TimTp
+	(*GnssEpoch)(nil),                 // 20: GnssEpoch
+	(*ReplayRecord)(nil),              // 21: ReplayRecord
+	(*ImuData_AccelerometerData)(nil), // 22: ImuData.AccelerometerData
+	(*ImuData_GyroscopeData)(nil),     // 23: ImuData.GyroscopeData
+	(*ImuData_FsyncData)(nil),         // 24: ImuData.FsyncData
+	(*GnssData_UbxSecEcsign)(nil),     // 25: GnssData.UbxSecEcsign
+	(*NavSat_Svs)(nil),                // 26: NavSat.Svs
+	(*NavSig_Sigs)(nil),               // 27: NavSig.Sigs
+	(*MonRf_RFBlock)(nil),             // 28: MonRf.RFBlock
+	(*RxmMeasx_RxmMeasxSVType)(nil),   // 29: RxmMeasx.RxmMeasxSVType
+	(*RxmRawx_RxmRawxMeasType)(nil),   // 30: RxmRawx.RxmRawxMeasType
+	(*RxmSfrbx_WordBlock)(nil),        // 31: RxmSfrbx.WordBlock
 }
 var file_sensordata_proto_depIdxs = []int32{
-	17, // 0: ImuData.accelerometer:type_name -> ImuData.AccelerometerData
-	18, // 1: ImuData.gyroscope:type_name -> ImuData.GyroscopeData
-	19, // 2: ImuData.fsync:type_name -> ImuData.FsyncData
-	20, // 3: GnssData.sec_ecsign:type_name -> GnssData.UbxSecEcsign
-	21, // 4: NavSat.svs:type_name -> NavSat.Svs
-	22, // 5: NavSig.sigs:type_name -> NavSig.Sigs
-	23, // 6: MonRf.rf_blocks:type_name -> MonRf.RFBlock
-	24, // 7: RxmMeasx.sv:type_name -> RxmMeasx.RxmMeasxSVType
-	25, // 8: RxmRawx.meas:type_name -> RxmRawx.RxmRawxMeasType
-	26, // 9: RxmSfrbx.word_block:type_name -> RxmSfrbx.WordBlock
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	22, // 0: ImuData.accelerometer:type_name -> ImuData.AccelerometerData
+	23, // 1: ImuData.gyroscope:type_name -> ImuData.GyroscopeData
+	24, // 2: ImuData.fsync:type_name -> ImuData.FsyncData
+	1,  // 3: ImuDataBatch.samples:type_name -> ImuData
+	2,  // 4: MagnetometerDataBatch.samples:type_name -> MagnetometerData
+	25, // 5: GnssData.sec_ecsign:type_name -> GnssData.UbxSecEcsign
+	0,  // 6: GnssData.auth_status:type_name -> GnssData.AuthStatus
+	26, // 7: NavSat.svs:type_name -> NavSat.Svs
+	27, // 8: NavSig.sigs:type_name -> NavSig.Sigs
+	28, // 9: MonRf.rf_blocks:type_name -> MonRf.RFBlock
+	29, // 10: RxmMeasx.sv:type_name -> RxmMeasx.RxmMeasxSVType
+	30, // 11: RxmRawx.meas:type_name -> RxmRawx.RxmRawxMeasType
+	31, // 12: RxmSfrbx.word_block:type_name -> RxmSfrbx.WordBlock
+	9,  // 13: GnssEpoch.nav_pvt:type_name -> NavPvt
+	10, // 14: GnssEpoch.nav_cov:type_name -> NavCov
+	6,  // 15: GnssEpoch.nav_dop:type_name -> NavDop
+	11, // 16: GnssEpoch.nav_posecef:type_name -> NavPosecef
+	12, // 17: GnssEpoch.nav_timegps:type_name -> NavTimegps
+	13, // 18: GnssEpoch.nav_velecef:type_name -> NavVelecef
+	14, // 19: GnssEpoch.nav_status:type_name -> NavStatus
+	8,  // 20: GnssEpoch.nav_sig:type_name -> NavSig
+	21, // [21:21] is the sub-list for method output_type
+	21, // [21:21] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_sensordata_proto_init() }
@@ -3286,7 +3734,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GnssData); i {
+			switch v := v.(*ImuDataBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3298,7 +3746,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NavDop); i {
+			switch v := v.(*MagnetometerDataBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3310,7 +3758,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NavSat); i {
+			switch v := v.(*GnssData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3322,7 +3770,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NavSig); i {
+			switch v := v.(*NavDop); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3334,7 +3782,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NavPvt); i {
+			switch v := v.(*NavSat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3346,7 +3794,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NavCov); i {
+			switch v := v.(*NavSig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3358,7 +3806,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NavPosecef); i {
+			switch v := v.(*NavPvt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3370,7 +3818,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NavTimegps); i {
+			switch v := v.(*NavCov); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3382,7 +3830,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NavVelecef); i {
+			switch v := v.(*NavPosecef); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3394,7 +3842,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NavStatus); i {
+			switch v := v.(*NavTimegps); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3406,7 +3854,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MonRf); i {
+			switch v := v.(*NavVelecef); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3418,7 +3866,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RxmMeasx); i {
+			switch v := v.(*NavStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3430,7 +3878,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RxmRawx); i {
+			switch v := v.(*MonRf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3442,7 +3890,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RxmSfrbx); i {
+			switch v := v.(*RxmMeasx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3454,7 +3902,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimTp); i {
+			switch v := v.(*RxmRawx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3466,7 +3914,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImuData_AccelerometerData); i {
+			switch v := v.(*RxmSfrbx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3478,7 +3926,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImuData_GyroscopeData); i {
+			switch v := v.(*TimTp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3490,7 +3938,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImuData_FsyncData); i {
+			switch v := v.(*GnssEpoch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3502,7 +3950,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GnssData_UbxSecEcsign); i {
+			switch v := v.(*ReplayRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3514,7 +3962,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NavSat_Svs); i {
+			switch v := v.(*ImuData_AccelerometerData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3526,7 +3974,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NavSig_Sigs); i {
+			switch v := v.(*ImuData_GyroscopeData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3538,7 +3986,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MonRf_RFBlock); i {
+			switch v := v.(*ImuData_FsyncData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3550,7 +3998,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RxmMeasx_RxmMeasxSVType); i {
+			switch v := v.(*GnssData_UbxSecEcsign); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3562,7 +4010,7 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RxmRawx_RxmRawxMeasType); i {
+			switch v := v.(*NavSat_Svs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3574,6 +4022,54 @@ func file_sensordata_proto_init() {
 			}
 		}
 		file_sensordata_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NavSig_Sigs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sensordata_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonRf_RFBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sensordata_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RxmMeasx_RxmMeasxSVType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sensordata_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RxmRawx_RxmRawxMeasType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sensordata_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RxmSfrbx_WordBlock); i {
 			case 0:
 				return &v.state
@@ -3591,13 +4087,14 @@ func file_sensordata_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sensordata_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   27,
+			NumEnums:      1,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_sensordata_proto_goTypes,
 		DependencyIndexes: file_sensordata_proto_depIdxs,
+		EnumInfos:         file_sensordata_proto_enumTypes,
 		MessageInfos:      file_sensordata_proto_msgTypes,
 	}.Build()
 	File_sensordata_proto = out.File
@@ -0,0 +1,169 @@
+package recording
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/device/neom9n"
+	"github.com/Hivemapper/hivemapper-data-logger/data/imu"
+	"github.com/daedaleanai/ublox/ubx"
+	"github.com/streamingfast/imu-controller/device/iim42652"
+)
+
+const maxSessionLineSize = 1024 * 1024
+
+// Handler receives the replayed records, it has the same inputs as the
+// DataHandler of the log command.
+type Handler interface {
+	HandleImu(acceleration *imu.Acceleration, angularRate *iim42652.AngularRate, temperature iim42652.Temperature, fsync *iim42652.Fsync) error
+	HandleMagnetometer(systemTime time.Time, x float64, y float64, z float64) error
+	HandleUbxMessage(msg interface{}) error
+	HandleGnssData(data *neom9n.Data) error
+}
+
+type PlayerOption func(*Player)
+
+// WithSpeed plays the session speed times faster than it was recorded, a
+// speed of 0 plays it as fast as possible.
+func WithSpeed(speed float64) PlayerOption {
+	return func(p *Player) {
+		p.speed = speed
+	}
+}
+
+// WithLoop restarts the session once its end is reached, until the context
+// is cancelled.
+func WithLoop() PlayerOption {
+	return func(p *Player) {
+		p.loop = true
+	}
+}
+
+// Player replays a session file to a Handler keeping the recorded relative
+// timing. Timestamps are shifted so the first record happens when the replay
+// starts, consumers see live looking data.
+type Player struct {
+	path    string
+	handler Handler
+
+	speed float64
+	loop  bool
+}
+
+func NewPlayer(path string, handler Handler, opts ...PlayerOption) *Player {
+	p := &Player{
+		path:    path,
+		handler: handler,
+		speed:   1,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+func (p *Player) Run(ctx context.Context) error {
+	for {
+		err := p.play(ctx)
+		if err != nil {
+			return err
+		}
+		if !p.loop || ctx.Err() != nil {
+			return nil
+		}
+		fmt.Println("looping session replay")
+	}
+}
+
+func (p *Player) play(ctx context.Context) error {
+	file, err := os.Open(p.path)
+	if err != nil {
+		return fmt.Errorf("opening session file: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), maxSessionLineSize)
+
+	var recordingStart time.Time
+	var replayStart time.Time
+	for scanner.Scan() {
+		record := &Record{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			fmt.Println("skipping invalid session record:", err)
+			continue
+		}
+
+		if recordingStart.IsZero() {
+			recordingStart = record.Time
+			replayStart = time.Now().UTC()
+		}
+		elapsed := record.Time.Sub(recordingStart)
+		if !p.waitUntil(ctx, replayStart, elapsed) {
+			return nil
+		}
+
+		record.shift(replayStart.Sub(recordingStart))
+		if err := p.dispatch(record); err != nil {
+			fmt.Printf("replaying %s record: %s\n", record.Type, err)
+		}
+	}
+	if err := scanner.Err(); err != nil && err != io.EOF {
+		return fmt.Errorf("reading session file: %w", err)
+	}
+	return nil
+}
+
+func (p *Player) dispatch(record *Record) error {
+	switch record.Type {
+	case RecordTypeImu:
+		if record.Imu == nil {
+			return fmt.Errorf("missing imu sample")
+		}
+		return p.handler.HandleImu(record.Imu.Acceleration, record.Imu.AngularRate, iim42652.NewTemperature(record.Imu.Temperature), record.Imu.Fsync)
+	case RecordTypeMagnetometer:
+		if record.Magnetometer == nil {
+			return fmt.Errorf("missing magnetometer sample")
+		}
+		return p.handler.HandleMagnetometer(record.Time, record.Magnetometer.X, record.Magnetometer.Y, record.Magnetometer.Z)
+	case RecordTypeUbx:
+		msg, err := ubx.Decode(record.Ubx)
+		if err != nil {
+			return fmt.Errorf("decoding ubx frame: %w", err)
+		}
+		return p.handler.HandleUbxMessage(msg)
+	case RecordTypeGnss:
+		if record.Gnss == nil {
+			return fmt.Errorf("missing gnss data")
+		}
+		return p.handler.HandleGnssData(record.Gnss)
+	}
+	return fmt.Errorf("unknown record type %q", record.Type)
+}
+
+// waitUntil sleeps until elapsed session time, scaled by the speed, has
+// passed since replayStart. It returns false when ctx is cancelled.
+func (p *Player) waitUntil(ctx context.Context, replayStart time.Time, elapsed time.Duration) bool {
+	if p.speed <= 0 {
+		return ctx.Err() == nil
+	}
+
+	wait := time.Until(replayStart.Add(time.Duration(float64(elapsed) / p.speed)))
+	if wait <= 0 {
+		return ctx.Err() == nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
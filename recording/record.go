@@ -0,0 +1,97 @@
+package recording
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/device/neom9n"
+	"github.com/Hivemapper/hivemapper-data-logger/data/imu"
+	"github.com/daedaleanai/ublox/ubx"
+	"github.com/streamingfast/imu-controller/device/iim42652"
+)
+
+const (
+	RecordTypeImu          = "imu"
+	RecordTypeMagnetometer = "magnetometer"
+	RecordTypeUbx          = "ubx"
+	RecordTypeGnss         = "gnss"
+)
+
+// Record is one entry of a session file, an input of the DataHandler. Time is
+// the sensor time of the record and orders the session.
+type Record struct {
+	Type         string              `json:"type"`
+	Time         time.Time           `json:"time"`
+	Imu          *ImuSample          `json:"imu,omitempty"`
+	Magnetometer *MagnetometerSample `json:"magnetometer,omitempty"`
+	// Ubx is the raw ubx frame of the message
+	Ubx  []byte       `json:"ubx,omitempty"`
+	Gnss *neom9n.Data `json:"gnss,omitempty"`
+}
+
+type ImuSample struct {
+	Acceleration *imu.Acceleration     `json:"acceleration"`
+	AngularRate  *iim42652.AngularRate `json:"angular_rate"`
+	Temperature  float64               `json:"temperature"`
+	Fsync        *iim42652.Fsync       `json:"fsync"`
+}
+
+type MagnetometerSample struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+func NewImuRecord(acceleration *imu.Acceleration, angularRate *iim42652.AngularRate, temperature iim42652.Temperature, fsync *iim42652.Fsync) *Record {
+	return &Record{
+		Type: RecordTypeImu,
+		Time: acceleration.Time,
+		Imu: &ImuSample{
+			Acceleration: acceleration,
+			AngularRate:  angularRate,
+			Temperature:  *temperature,
+			Fsync:        fsync,
+		},
+	}
+}
+
+func NewMagnetometerRecord(systemTime time.Time, x float64, y float64, z float64) *Record {
+	return &Record{
+		Type:         RecordTypeMagnetometer,
+		Time:         systemTime,
+		Magnetometer: &MagnetometerSample{X: x, Y: y, Z: z},
+	}
+}
+
+func NewUbxRecord(systemTime time.Time, msg ubx.Message) (*Record, error) {
+	frame, err := ubx.Encode(msg)
+	if err != nil {
+		return nil, fmt.Errorf("encoding %T: %w", msg, err)
+	}
+	return &Record{
+		Type: RecordTypeUbx,
+		Time: systemTime,
+		Ubx:  frame,
+	}, nil
+}
+
+func NewGnssRecord(data *neom9n.Data) *Record {
+	// the gnss data feed reuses the same Data for every message
+	copied := *data
+	return &Record{
+		Type: RecordTypeGnss,
+		Time: data.SystemTime,
+		Gnss: &copied,
+	}
+}
+
+// shift moves every timestamp of the record by offset
+func (r *Record) shift(offset time.Duration) {
+	r.Time = r.Time.Add(offset)
+	switch {
+	case r.Imu != nil && r.Imu.Acceleration != nil:
+		r.Imu.Acceleration.Time = r.Imu.Acceleration.Time.Add(offset)
+	case r.Gnss != nil:
+		r.Gnss.SystemTime = r.Gnss.SystemTime.Add(offset)
+	}
+}
@@ -0,0 +1,115 @@
+package recording
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/device/neom9n"
+	"github.com/Hivemapper/hivemapper-data-logger/data/imu"
+	"github.com/daedaleanai/ublox/ubx"
+	"github.com/streamingfast/imu-controller/device/iim42652"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testHandler struct {
+	types        []string
+	imu          []*imu.Acceleration
+	temperatures []float64
+	magnetometer []time.Time
+	ubx          []interface{}
+	gnss         []*neom9n.Data
+}
+
+func (h *testHandler) HandleImu(acceleration *imu.Acceleration, _ *iim42652.AngularRate, temperature iim42652.Temperature, _ *iim42652.Fsync) error {
+	h.types = append(h.types, RecordTypeImu)
+	h.imu = append(h.imu, acceleration)
+	h.temperatures = append(h.temperatures, *temperature)
+	return nil
+}
+
+func (h *testHandler) HandleMagnetometer(systemTime time.Time, _ float64, _ float64, _ float64) error {
+	h.types = append(h.types, RecordTypeMagnetometer)
+	h.magnetometer = append(h.magnetometer, systemTime)
+	return nil
+}
+
+func (h *testHandler) HandleUbxMessage(msg interface{}) error {
+	h.types = append(h.types, RecordTypeUbx)
+	h.ubx = append(h.ubx, msg)
+	return nil
+}
+
+func (h *testHandler) HandleGnssData(data *neom9n.Data) error {
+	h.types = append(h.types, RecordTypeGnss)
+	h.gnss = append(h.gnss, data)
+	return nil
+}
+
+func Test_RecordAndPlay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	start := time.Now().UTC().Add(-time.Minute)
+
+	writer := NewWriter(path)
+	require.NoError(t, writer.Open())
+
+	// records out of order within the reorder window get written in order
+	writer.RecordMagnetometer(start.Add(20*time.Millisecond), 1, 2, 3)
+	writer.RecordImu(
+		imu.NewAcceleration(0.1, 0.2, 0.9, 1, start),
+		&iim42652.AngularRate{X: 1},
+		iim42652.NewTemperature(31.5),
+		&iim42652.Fsync{FsyncInt: true},
+	)
+	writer.RecordGnssData(&neom9n.Data{SystemTime: start.Add(30 * time.Millisecond)})
+	writer.RecordUbxMessage(&ubx.NavPvt{ITOW_ms: 1234})
+	// not an ubx message, only the ubx messages are recorded
+	writer.RecordUbxMessage("not a message")
+	require.NoError(t, writer.Close())
+
+	handler := &testHandler{}
+	replayStart := time.Now().UTC()
+	require.NoError(t, NewPlayer(path, handler, WithSpeed(0)).Run(context.Background()))
+
+	assert.Equal(t, []string{RecordTypeImu, RecordTypeMagnetometer, RecordTypeGnss, RecordTypeUbx}, handler.types)
+
+	require.Len(t, handler.imu, 1)
+	assert.Equal(t, 0.9, handler.imu[0].Z)
+	assert.Equal(t, 31.5, handler.temperatures[0])
+	assert.False(t, handler.imu[0].Time.Before(replayStart), "timestamps are shifted to the replay")
+
+	require.Len(t, handler.magnetometer, 1)
+	assert.Equal(t, 20*time.Millisecond, handler.magnetometer[0].Sub(handler.imu[0].Time))
+	require.Len(t, handler.gnss, 1)
+	assert.Equal(t, 30*time.Millisecond, handler.gnss[0].SystemTime.Sub(handler.imu[0].Time))
+
+	require.Len(t, handler.ubx, 1)
+	navPvt, ok := handler.ubx[0].(*ubx.NavPvt)
+	require.True(t, ok)
+	assert.Equal(t, uint32(1234), navPvt.ITOW_ms)
+}
+
+func Test_PlayerPacing(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	start := time.Now().UTC().Add(-time.Minute)
+
+	writer := NewWriter(path)
+	require.NoError(t, writer.Open())
+	writer.RecordMagnetometer(start, 1, 2, 3)
+	writer.RecordMagnetometer(start.Add(200*time.Millisecond), 1, 2, 3)
+	require.NoError(t, writer.Close())
+
+	handler := &testHandler{}
+	begin := time.Now()
+	require.NoError(t, NewPlayer(path, handler, WithSpeed(2)).Run(context.Background()))
+	assert.GreaterOrEqual(t, time.Since(begin), 100*time.Millisecond)
+	assert.Len(t, handler.magnetometer, 2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 150*time.Millisecond)
+	defer cancel()
+	handler = &testHandler{}
+	require.NoError(t, NewPlayer(path, handler, WithLoop()).Run(ctx))
+	assert.Len(t, handler.magnetometer, 1, "cancelled before the second record")
+}
@@ -0,0 +1,175 @@
+package recording
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/device/neom9n"
+	"github.com/Hivemapper/hivemapper-data-logger/data/imu"
+	"github.com/daedaleanai/ublox/ubx"
+	"github.com/streamingfast/imu-controller/device/iim42652"
+)
+
+const writerQueueSize = 4000
+
+// reorderWindow is how long records are held before being written. The
+// sensors are read by different goroutines and imu samples are timestamped
+// when their fifo is read, records within the window get written in order.
+const reorderWindow = time.Second
+
+// Writer records the DataHandler inputs into a session file of JSON lines,
+// ordered by sensor time. Recording never blocks the sensor feeds, records
+// are dropped when the writer can't keep up.
+type Writer struct {
+	path string
+
+	file    *os.File
+	output  *bufio.Writer
+	records chan *Record
+	done    chan struct{}
+
+	lock    sync.Mutex
+	closed  bool
+	dropped int
+}
+
+func NewWriter(path string) *Writer {
+	return &Writer{
+		path:    path,
+		records: make(chan *Record, writerQueueSize),
+		done:    make(chan struct{}),
+	}
+}
+
+func (w *Writer) Open() error {
+	file, err := os.Create(w.path)
+	if err != nil {
+		return fmt.Errorf("creating session file: %w", err)
+	}
+	w.file = file
+	w.output = bufio.NewWriter(file)
+
+	go w.run()
+	return nil
+}
+
+func (w *Writer) RecordImu(acceleration *imu.Acceleration, angularRate *iim42652.AngularRate, temperature iim42652.Temperature, fsync *iim42652.Fsync) {
+	w.record(NewImuRecord(acceleration, angularRate, temperature, fsync))
+}
+
+func (w *Writer) RecordMagnetometer(systemTime time.Time, x float64, y float64, z float64) {
+	w.record(NewMagnetometerRecord(systemTime, x, y, z))
+}
+
+func (w *Writer) RecordUbxMessage(msg interface{}) {
+	ubxMessage, ok := msg.(ubx.Message)
+	if !ok {
+		return
+	}
+	record, err := NewUbxRecord(time.Now().UTC(), ubxMessage)
+	if err != nil {
+		fmt.Println("recording ubx message:", err)
+		return
+	}
+	w.record(record)
+}
+
+func (w *Writer) RecordGnssData(data *neom9n.Data) {
+	w.record(NewGnssRecord(data))
+}
+
+func (w *Writer) Close() error {
+	w.lock.Lock()
+	if w.closed {
+		w.lock.Unlock()
+		return nil
+	}
+	w.closed = true
+	close(w.records)
+	w.lock.Unlock()
+
+	<-w.done
+	if w.dropped > 0 {
+		fmt.Printf("session recording dropped %d records\n", w.dropped)
+	}
+	if err := w.output.Flush(); err != nil {
+		return fmt.Errorf("flushing session file: %w", err)
+	}
+	return w.file.Close()
+}
+
+func (w *Writer) record(record *Record) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.closed {
+		return
+	}
+	select {
+	case w.records <- record:
+	default:
+		w.dropped++
+	}
+}
+
+func (w *Writer) run() {
+	defer close(w.done)
+
+	pending := &recordHeap{}
+	ticker := time.NewTicker(reorderWindow / 10)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case record, ok := <-w.records:
+			if !ok {
+				w.writeUntil(pending, time.Time{})
+				return
+			}
+			heap.Push(pending, record)
+		case now := <-ticker.C:
+			w.writeUntil(pending, now.Add(-reorderWindow))
+		}
+	}
+}
+
+// writeUntil writes the pending records older than cutoff, all of them for a
+// zero cutoff.
+func (w *Writer) writeUntil(pending *recordHeap, cutoff time.Time) {
+	for pending.Len() > 0 {
+		if !cutoff.IsZero() && (*pending)[0].Time.After(cutoff) {
+			return
+		}
+		record := heap.Pop(pending).(*Record)
+		line, err := json.Marshal(record)
+		if err != nil {
+			fmt.Println("marshalling session record:", err)
+			continue
+		}
+		if _, err := w.output.Write(append(line, '\n')); err != nil {
+			fmt.Println("writing session record:", err)
+		}
+	}
+}
+
+type recordHeap []*Record
+
+func (h recordHeap) Len() int           { return len(h) }
+func (h recordHeap) Less(i, j int) bool { return h[i].Time.Before(h[j].Time) }
+func (h recordHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *recordHeap) Push(x interface{}) {
+	*h = append(*h, x.(*Record))
+}
+
+func (h *recordHeap) Pop() interface{} {
+	old := *h
+	record := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return record
+}
@@ -74,6 +74,7 @@ func splitFunc(data []byte, atEOF bool) (advance int, token []byte, err error) {
 }
 
 // Decode reads on NMEA or UBX frame and calls nmea.Decode or ubx.Decode accordingly to parse the message.
+// The bytes of the frame are only valid until the next call.
 func (d *Decoder) Decode() (msg interface{}, bytes []byte, err error) {
 	if !d.s.Scan() {
 		if err = d.s.Err(); err == nil {
@@ -87,6 +88,7 @@ func (d *Decoder) Decode() (msg interface{}, bytes []byte, err error) {
 		msg, err := ubx.Decode(d.s.Bytes())
 		return msg, d.s.Bytes(), err
 	}
-	return nil, nil, err
+	// NMEA sentences are not decoded, the frame is returned without its "\r\n"
+	return nil, d.s.Bytes(), err
 
 }
@@ -0,0 +1,272 @@
+package ubx
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// CfgKeyID identifies a configuration item of CFG-VALSET, CFG-VALGET and
+// CFG-VALDEL. Bits 28..30 hold the storage size of its value.
+type CfgKeyID uint32
+
+// Size returns the number of bytes the value of the key takes in a message,
+// 1 for a single bit and 0 for an invalid ID.
+func (id CfgKeyID) Size() int {
+	switch (id >> 28) & 0x7 {
+	case 1, 2:
+		return 1
+	case 3:
+		return 2
+	case 4:
+		return 4
+	case 5:
+		return 8
+	}
+	return 0
+}
+
+func (id CfgKeyID) String() string {
+	if key, ok := CfgKeyByID(id); ok {
+		return key.Name
+	}
+	return fmt.Sprintf("0x%08x", uint32(id))
+}
+
+// CfgType is the type of a configuration value, as in the interface
+// description: L for a bit, U/I for unsigned/signed integers, X for bitfields,
+// E for enumerations and R for floating point numbers, followed by the size
+// in bytes.
+type CfgType string
+
+func (t CfgType) signed() bool { return strings.HasPrefix(string(t), "I") }
+
+// CfgEnumValue is a named value of an enumeration key
+type CfgEnumValue struct {
+	Name  string
+	Value int64
+}
+
+// CfgKey describes a configuration key of the M9 receivers, see cfgkeys.xml.
+type CfgKey struct {
+	Name        string
+	ID          CfgKeyID
+	Type        CfgType
+	Scale       float64 // 0 when the value isn't scaled
+	Unit        string
+	Description string
+	Enum        []CfgEnumValue
+}
+
+var (
+	cfgKeysByName = map[string]*CfgKey{}
+	cfgKeysByID   = map[CfgKeyID]*CfgKey{}
+)
+
+func init() {
+	for _, key := range cfgKeys {
+		cfgKeysByName[key.Name] = key
+		cfgKeysByID[key.ID] = key
+	}
+}
+
+// CfgKeys returns all the known configuration keys, sorted by name.
+func CfgKeys() []*CfgKey { return append([]*CfgKey(nil), cfgKeys...) }
+
+// CfgKeyByName returns the key called name, e.g. CFG-RATE-MEAS
+func CfgKeyByName(name string) (*CfgKey, bool) {
+	key, ok := cfgKeysByName[name]
+	return key, ok
+}
+
+// CfgKeyByID returns the key identified by id
+func CfgKeyByID(id CfgKeyID) (*CfgKey, bool) {
+	key, ok := cfgKeysByID[id]
+	return key, ok
+}
+
+// Encode returns value as the little endian bytes of the key in a CFG-VALSET.
+// Bits take a bool or 0/1, enumerations one of their names or an integer,
+// floating point keys any number and the other keys an integer, including a
+// json.Number or its string. The value isn't scaled.
+func (k *CfgKey) Encode(value interface{}) ([]byte, error) {
+	if value == nil {
+		return nil, fmt.Errorf("missing value")
+	}
+	size := k.ID.Size()
+
+	if k.Type == "L" {
+		switch v := value.(type) {
+		case bool:
+			if v {
+				return []byte{0x01}, nil
+			}
+			return []byte{0x00}, nil
+		}
+		switch fmt.Sprint(value) {
+		case "0":
+			return []byte{0x00}, nil
+		case "1":
+			return []byte{0x01}, nil
+		}
+		return nil, fmt.Errorf("expected a boolean, got %v", value)
+	}
+	if b, ok := value.(bool); ok {
+		return nil, fmt.Errorf("expected %s, got %v", k.expected(), b)
+	}
+
+	if k.Type == "R4" || k.Type == "R8" {
+		f, err := strconv.ParseFloat(fmt.Sprint(value), 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected a number, got %v", value)
+		}
+		data := make([]byte, size)
+		if k.Type == "R4" {
+			binary.LittleEndian.PutUint32(data, math.Float32bits(float32(f)))
+		} else {
+			binary.LittleEndian.PutUint64(data, math.Float64bits(f))
+		}
+		return data, nil
+	}
+
+	if name, ok := value.(string); ok && len(k.Enum) > 0 {
+		if _, err := json.Number(name).Int64(); err != nil {
+			for _, e := range k.Enum {
+				if strings.EqualFold(e.Name, name) {
+					value = e.Value
+					break
+				}
+			}
+			if _, ok := value.(string); ok {
+				return nil, fmt.Errorf("unknown value %q, one of %s", name, k.enumNames())
+			}
+		}
+	}
+
+	n, ok := new(big.Int).SetString(fmt.Sprint(value), 0)
+	if !ok {
+		return nil, fmt.Errorf("expected %s, got %v", k.expected(), value)
+	}
+
+	bits := uint(size * 8)
+	limit := new(big.Int).Lsh(big.NewInt(1), bits)
+	min := big.NewInt(0)
+	if k.Type.signed() {
+		limit.Rsh(limit, 1)
+		min.Neg(limit)
+	}
+	if n.Cmp(min) < 0 || n.Cmp(limit) >= 0 {
+		return nil, fmt.Errorf("%v doesn't fit in %s", value, k.Type)
+	}
+	if n.Sign() < 0 {
+		n.Add(n, new(big.Int).Lsh(big.NewInt(1), bits))
+	}
+
+	data := make([]byte, size)
+	bigEndian := n.Bytes()
+	for i, b := range bigEndian {
+		data[len(bigEndian)-1-i] = b
+	}
+	return data, nil
+}
+
+func (k *CfgKey) expected() string {
+	if len(k.Enum) > 0 {
+		return "one of " + k.enumNames()
+	}
+	return "an integer"
+}
+
+func (k *CfgKey) enumNames() string {
+	names := make([]string, len(k.Enum))
+	for i, e := range k.Enum {
+		names[i] = e.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// Decode returns the value of the key in a CFG-VALGET reply: a bool for bits,
+// float32 or float64 for floating point keys, and the signed or unsigned
+// integer of the size of the key otherwise.
+func (k *CfgKey) Decode(data []byte) (interface{}, error) {
+	if len(data) != k.ID.Size() {
+		return nil, fmt.Errorf("%s: expected %d bytes, got %d", k.Name, k.ID.Size(), len(data))
+	}
+
+	switch k.Type {
+	case "L":
+		return data[0]&0x01 != 0, nil
+	case "R4":
+		return math.Float32frombits(binary.LittleEndian.Uint32(data)), nil
+	case "R8":
+		return math.Float64frombits(binary.LittleEndian.Uint64(data)), nil
+	}
+
+	signed := k.Type.signed()
+	switch len(data) {
+	case 1:
+		if signed {
+			return int8(data[0]), nil
+		}
+		return data[0], nil
+	case 2:
+		v := binary.LittleEndian.Uint16(data)
+		if signed {
+			return int16(v), nil
+		}
+		return v, nil
+	case 4:
+		v := binary.LittleEndian.Uint32(data)
+		if signed {
+			return int32(v), nil
+		}
+		return v, nil
+	default:
+		v := binary.LittleEndian.Uint64(data)
+		if signed {
+			return int64(v), nil
+		}
+		return v, nil
+	}
+}
+
+// Format returns the value of the key in a CFG-VALGET reply for humans: the
+// name of enumerated values, bitfields in hex and scaled values with their
+// unit, e.g. "250 (0.25 s)".
+func (k *CfgKey) Format(data []byte) string {
+	value, err := k.Decode(data)
+	if err != nil {
+		return fmt.Sprintf("% x (invalid)", data)
+	}
+
+	switch k.Type[0] {
+	case 'E':
+		n, _ := strconv.ParseInt(fmt.Sprint(value), 10, 64)
+		for _, e := range k.Enum {
+			if e.Value == n {
+				return fmt.Sprintf("%s (%d)", e.Name, n)
+			}
+		}
+		return fmt.Sprintf("%d", n)
+	case 'X':
+		return fmt.Sprintf("0x%0*x", 2*len(data), value)
+	}
+
+	s := fmt.Sprint(value)
+	if k.Scale != 0 {
+		f, _ := strconv.ParseFloat(s, 64)
+		scaled := fmt.Sprintf("%.6g", f*k.Scale)
+		if k.Unit != "" {
+			scaled += " " + k.Unit
+		}
+		return fmt.Sprintf("%s (%s)", s, scaled)
+	}
+	if k.Unit != "" {
+		return s + " " + k.Unit
+	}
+	return s
+}
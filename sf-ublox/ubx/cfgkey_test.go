@@ -0,0 +1,197 @@
+package ubx
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCfgKeys(t *testing.T) {
+	if len(cfgKeys) != len(cfgKeysByName) || len(cfgKeys) != len(cfgKeysByID) {
+		t.Fatalf("duplicate keys: %d keys, %d names, %d ids", len(cfgKeys), len(cfgKeysByName), len(cfgKeysByID))
+	}
+	for _, key := range cfgKeys {
+		if key.ID.Size() == 0 {
+			t.Errorf("%s: invalid id %s", key.Name, key.ID)
+		}
+		if byName, _ := CfgKeyByName(key.Name); byName != key {
+			t.Errorf("%s: not found by name", key.Name)
+		}
+	}
+
+	key, ok := CfgKeyByName("CFG-RATE-MEAS")
+	if !ok || key.ID != 0x30210001 || key.Type != "U2" || key.Scale != 0.001 || key.Unit != "s" {
+		t.Errorf("CFG-RATE-MEAS: got %+v", key)
+	}
+	if _, ok := CfgKeyByName("CFG-RATE-MEASURE"); ok {
+		t.Errorf("CFG-RATE-MEASURE: found")
+	}
+}
+
+func TestCfgKeyIDSize(t *testing.T) {
+	for _, tc := range []struct {
+		id   CfgKeyID
+		size int
+	}{
+		{CfgKeyNavspgAckaiding, 1},
+		{CfgKeyMsgoutUbxNavPvtUart1, 1},
+		{CfgKeyRateMeas, 2},
+		{CfgKeyUart1Baudrate, 4},
+		{CfgKeyTpDutyTp1, 8},
+		{0x00000001, 0},
+		{0x70000001, 0},
+	} {
+		if size := tc.id.Size(); size != tc.size {
+			t.Errorf("%s: got size %d, expected %d", tc.id, size, tc.size)
+		}
+	}
+}
+
+func TestCfgKeyEncode(t *testing.T) {
+	for _, tc := range []struct {
+		key      string
+		value    interface{}
+		expected []byte
+		err      string
+	}{
+		{"CFG-NAVSPG-ACKAIDING", true, []byte{0x01}, ""},
+		{"CFG-NAVSPG-ACKAIDING", json.Number("0"), []byte{0x00}, ""},
+		{"CFG-NAVSPG-ACKAIDING", 2, nil, "expected a boolean, got 2"},
+		{"CFG-RATE-MEAS", 250, []byte{0xfa, 0x00}, ""},
+		{"CFG-RATE-MEAS", json.Number("1000"), []byte{0xe8, 0x03}, ""},
+		{"CFG-RATE-MEAS", 250.0, []byte{0xfa, 0x00}, ""},
+		{"CFG-RATE-MEAS", 0.25, nil, "expected an integer, got 0.25"},
+		{"CFG-RATE-MEAS", true, nil, "expected an integer, got true"},
+		{"CFG-RATE-MEAS", 65536, nil, "65536 doesn't fit in U2"},
+		{"CFG-RATE-MEAS", -1, nil, "-1 doesn't fit in U2"},
+		{"CFG-MSGOUT-UBX_NAV_PVT_UART1", 256, nil, "256 doesn't fit in U1"},
+		{"CFG-NAVSPG-INFIL_MINELEV", -5, []byte{0xfb}, ""},
+		{"CFG-NAVSPG-INFIL_MINELEV", 128, nil, "128 doesn't fit in I1"},
+		{"CFG-TP-USER_DELAY_TP1", int64(-2), []byte{0xfe, 0xff, 0xff, 0xff}, ""},
+		{"CFG-TP-PERIOD_TP1", uint32(1000000), []byte{0x40, 0x42, 0x0f, 0x00}, ""},
+		{"CFG-INFMSG-UBX_UART1", "0x07", []byte{0x07}, ""},
+		{"CFG-NAVSPG-DYNMODEL", "AUTOMOT", []byte{0x04}, ""},
+		{"CFG-NAVSPG-DYNMODEL", "automot", []byte{0x04}, ""},
+		{"CFG-NAVSPG-DYNMODEL", json.Number("4"), []byte{0x04}, ""},
+		{"CFG-NAVSPG-DYNMODEL", "CAR", nil, `unknown value "CAR", one of PORT, STAT, PED, AUTOMOT, SEA, AIR1, AIR2, AIR4, WRIST`},
+		{"CFG-TP-DUTY_TP1", 12.5, []byte{0, 0, 0, 0, 0, 0, 0x29, 0x40}, ""},
+		{"CFG-RATE-NAV", nil, nil, "missing value"},
+	} {
+		key, ok := CfgKeyByName(tc.key)
+		if !ok {
+			t.Fatalf("%s: not found", tc.key)
+		}
+		data, err := key.Encode(tc.value)
+		if tc.err != "" {
+			if err == nil || err.Error() != tc.err {
+				t.Errorf("%s %v: got error %v, expected %q", tc.key, tc.value, err, tc.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s %v: %v", tc.key, tc.value, err)
+			continue
+		}
+		if !bytes.Equal(data, tc.expected) {
+			t.Errorf("%s %v: got % x, expected % x", tc.key, tc.value, data, tc.expected)
+		}
+	}
+}
+
+func TestCfgKeyDecode(t *testing.T) {
+	for _, tc := range []struct {
+		key      string
+		data     []byte
+		expected interface{}
+		format   string
+	}{
+		{"CFG-NAVSPG-ACKAIDING", []byte{0x01}, true, "true"},
+		{"CFG-RATE-MEAS", []byte{0xfa, 0x00}, uint16(250), "250 (0.25 s)"},
+		{"CFG-RATE-NAV", []byte{0x01, 0x00}, uint16(1), "1"},
+		{"CFG-NAVSPG-INFIL_MINELEV", []byte{0xfb}, int8(-5), "-5 deg"},
+		{"CFG-NAVSPG-OUTFIL_PDOP", []byte{0xfa, 0x00}, uint16(250), "250 (25)"},
+		{"CFG-UART1-BAUDRATE", []byte{0x00, 0x10, 0x0e, 0x00}, uint32(921600), "921600"},
+		{"CFG-TP-PERIOD_TP1", []byte{0x40, 0x42, 0x0f, 0x00}, uint32(1000000), "1000000 (1 s)"},
+		{"CFG-NAVSPG-DYNMODEL", []byte{0x04}, uint8(4), "AUTOMOT (4)"},
+		{"CFG-NAVSPG-DYNMODEL", []byte{0x01}, uint8(1), "1"},
+		{"CFG-INFMSG-UBX_UART1", []byte{0x07}, uint8(7), "0x07"},
+		{"CFG-TP-DUTY_TP1", []byte{0, 0, 0, 0, 0, 0, 0x29, 0x40}, 12.5, "12.5 %"},
+	} {
+		key, _ := CfgKeyByName(tc.key)
+		value, err := key.Decode(tc.data)
+		if err != nil {
+			t.Errorf("%s: %v", tc.key, err)
+			continue
+		}
+		if !reflect.DeepEqual(value, tc.expected) {
+			t.Errorf("%s: got %T %v, expected %T %v", tc.key, value, value, tc.expected, tc.expected)
+		}
+		if s := key.Format(tc.data); s != tc.format {
+			t.Errorf("%s: got %q, expected %q", tc.key, s, tc.format)
+		}
+	}
+
+	key, _ := CfgKeyByName("CFG-RATE-MEAS")
+	if _, err := key.Decode([]byte{0x01}); err == nil {
+		t.Errorf("decoding 1 byte of CFG-RATE-MEAS: expected an error")
+	}
+}
+
+func TestCfgValGet(t *testing.T) {
+	msg := &CfgValGet{
+		Version:  0x01,
+		Layer:    CfgValGetLayerFlash,
+		Position: 0,
+		CfgData: []*CfgData{
+			{Key: CfgKeyRateMeas, Value: []byte{0xfa, 0x00}},
+			{Key: CfgKeyNavspgDynmodel, Value: []byte{0x04}},
+			{Key: 0x20990001, Value: []byte{0x02}},
+		},
+	}
+	frame, err := Encode(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := Decode(frame)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, msg) {
+		t.Errorf("got %#v, expected %#v", decoded, msg)
+	}
+
+	expected := strings.Join([]string{
+		"CFG-VALGET flash layer, position 0",
+		"  CFG-RATE-MEAS = 250 (0.25 s)",
+		"  CFG-NAVSPG-DYNMODEL = AUTOMOT (4)",
+		"  0x20990001 = 02",
+	}, "\n")
+	if s := decoded.(*CfgValGet).String(); s != expected {
+		t.Errorf("got %q, expected %q", s, expected)
+	}
+	if value, ok := decoded.(*CfgValGet).Value(CfgKeyNavspgDynmodel); !ok || !bytes.Equal(value, []byte{0x04}) {
+		t.Errorf("CFG-NAVSPG-DYNMODEL: got % x", value)
+	}
+
+	// a poll request isn't a reply
+	frame, err = Encode(&CfgValGetReq{Layer: CfgValGetLayerRam, Keys: []CfgKeyID{CfgKeyRateMeas}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(frame[6:len(frame)-2], []byte{0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x21, 0x30}) {
+		t.Errorf("CFG-VALGET poll: got % x", frame)
+	}
+	if decoded, err := Decode(frame); err != nil {
+		t.Error(err)
+	} else if _, ok := decoded.(*RawMessage); !ok {
+		t.Errorf("CFG-VALGET poll: got %T", decoded)
+	}
+
+	// the key of the value is unknown, its size too
+	frame, _ = Encode(&CfgValGet{Version: 0x01, CfgData: []*CfgData{{Key: 0x00000001, Value: []byte{0x01}}}})
+	if _, err := Decode(frame); err == nil {
+		t.Errorf("CFG-VALGET with an invalid key: expected an error")
+	}
+}
@@ -0,0 +1,935 @@
+// Generated Code -- DO NOT EDIT.
+//go:generate go run cfgkeysgen.go cfgkeys.tmpl cfgkeys.xml cfgkeys.go
+
+package ubx
+
+// Configuration key IDs
+const (
+	CfgKeyI2cAddress               CfgKeyID = 0x20510001 // I2C slave address of the receiver (7 bits) (U1)
+	CfgKeyI2cEnabled               CfgKeyID = 0x10510003 // Flag to indicate if the I2C interface should be enabled (L)
+	CfgKeyI2cinprotNmea            CfgKeyID = 0x10710002 // Flag to indicate if NMEA should be an input protocol on I2C (L)
+	CfgKeyI2cinprotUbx             CfgKeyID = 0x10710001 // Flag to indicate if UBX should be an input protocol on I2C (L)
+	CfgKeyI2coutprotNmea           CfgKeyID = 0x10720002 // Flag to indicate if NMEA should be an output protocol on I2C (L)
+	CfgKeyI2coutprotUbx            CfgKeyID = 0x10720001 // Flag to indicate if UBX should be an output protocol on I2C (L)
+	CfgKeyInfmsgNmeaUart1          CfgKeyID = 0x20920007 // Information message enable flags for the NMEA protocol on the UART1 interface (X1)
+	CfgKeyInfmsgUbxUart1           CfgKeyID = 0x20920002 // Information message enable flags for the UBX protocol on the UART1 interface (X1)
+	CfgKeyItfmEnable               CfgKeyID = 0x1041000d // Enable interference detection (L)
+	CfgKeyMsgoutNmeaIdGgaI2c       CfgKeyID = 0x209100ba // Output rate of the NMEA-GX-GGA message on port I2C (U1)
+	CfgKeyMsgoutNmeaIdGgaSpi       CfgKeyID = 0x209100be // Output rate of the NMEA-GX-GGA message on port SPI (U1)
+	CfgKeyMsgoutNmeaIdGgaUart1     CfgKeyID = 0x209100bb // Output rate of the NMEA-GX-GGA message on port UART1 (U1)
+	CfgKeyMsgoutNmeaIdGllI2c       CfgKeyID = 0x209100c9 // Output rate of the NMEA-GX-GLL message on port I2C (U1)
+	CfgKeyMsgoutNmeaIdGllSpi       CfgKeyID = 0x209100cd // Output rate of the NMEA-GX-GLL message on port SPI (U1)
+	CfgKeyMsgoutNmeaIdGllUart1     CfgKeyID = 0x209100ca // Output rate of the NMEA-GX-GLL message on port UART1 (U1)
+	CfgKeyMsgoutNmeaIdGsaI2c       CfgKeyID = 0x209100bf // Output rate of the NMEA-GX-GSA message on port I2C (U1)
+	CfgKeyMsgoutNmeaIdGsaSpi       CfgKeyID = 0x209100c3 // Output rate of the NMEA-GX-GSA message on port SPI (U1)
+	CfgKeyMsgoutNmeaIdGsaUart1     CfgKeyID = 0x209100c0 // Output rate of the NMEA-GX-GSA message on port UART1 (U1)
+	CfgKeyMsgoutNmeaIdGsvI2c       CfgKeyID = 0x209100c4 // Output rate of the NMEA-GX-GSV message on port I2C (U1)
+	CfgKeyMsgoutNmeaIdGsvSpi       CfgKeyID = 0x209100c8 // Output rate of the NMEA-GX-GSV message on port SPI (U1)
+	CfgKeyMsgoutNmeaIdGsvUart1     CfgKeyID = 0x209100c5 // Output rate of the NMEA-GX-GSV message on port UART1 (U1)
+	CfgKeyMsgoutNmeaIdRmcI2c       CfgKeyID = 0x209100ab // Output rate of the NMEA-GX-RMC message on port I2C (U1)
+	CfgKeyMsgoutNmeaIdRmcSpi       CfgKeyID = 0x209100af // Output rate of the NMEA-GX-RMC message on port SPI (U1)
+	CfgKeyMsgoutNmeaIdRmcUart1     CfgKeyID = 0x209100ac // Output rate of the NMEA-GX-RMC message on port UART1 (U1)
+	CfgKeyMsgoutNmeaIdVtgI2c       CfgKeyID = 0x209100b0 // Output rate of the NMEA-GX-VTG message on port I2C (U1)
+	CfgKeyMsgoutNmeaIdVtgSpi       CfgKeyID = 0x209100b4 // Output rate of the NMEA-GX-VTG message on port SPI (U1)
+	CfgKeyMsgoutNmeaIdVtgUart1     CfgKeyID = 0x209100b1 // Output rate of the NMEA-GX-VTG message on port UART1 (U1)
+	CfgKeyMsgoutUbxMonRfUart1      CfgKeyID = 0x2091035a // Output rate of the UBX-MON-RF message on port UART1 (U1)
+	CfgKeyMsgoutUbxMonSpanUart1    CfgKeyID = 0x2091038c // Output rate of the UBX-MON-SPAN message on port UART1 (U1)
+	CfgKeyMsgoutUbxMonSysUart1     CfgKeyID = 0x2091069e // Output rate of the UBX-MON-SYS message on port UART1 (U1)
+	CfgKeyMsgoutUbxNavClockUart1   CfgKeyID = 0x20910066 // Output rate of the UBX-NAV-CLOCK message on port UART1 (U1)
+	CfgKeyMsgoutUbxNavCovUart1     CfgKeyID = 0x20910084 // Output rate of the UBX-NAV-COV message on port UART1 (U1)
+	CfgKeyMsgoutUbxNavDopUart1     CfgKeyID = 0x20910039 // Output rate of the UBX-NAV-DOP message on port UART1 (U1)
+	CfgKeyMsgoutUbxNavEoeUart1     CfgKeyID = 0x20910160 // Output rate of the UBX-NAV-EOE message on port UART1 (U1)
+	CfgKeyMsgoutUbxNavPosecefUart1 CfgKeyID = 0x20910025 // Output rate of the UBX-NAV-POSECEF message on port UART1 (U1)
+	CfgKeyMsgoutUbxNavPosllhUart1  CfgKeyID = 0x2091002a // Output rate of the UBX-NAV-POSLLH message on port UART1 (U1)
+	CfgKeyMsgoutUbxNavPvtUart1     CfgKeyID = 0x20910007 // Output rate of the UBX-NAV-PVT message on port UART1 (U1)
+	CfgKeyMsgoutUbxNavSatUart1     CfgKeyID = 0x20910016 // Output rate of the UBX-NAV-SAT message on port UART1 (U1)
+	CfgKeyMsgoutUbxNavSigUart1     CfgKeyID = 0x20910346 // Output rate of the UBX-NAV-SIG message on port UART1 (U1)
+	CfgKeyMsgoutUbxNavStatusUart1  CfgKeyID = 0x2091001b // Output rate of the UBX-NAV-STATUS message on port UART1 (U1)
+	CfgKeyMsgoutUbxNavTimegpsUart1 CfgKeyID = 0x20910048 // Output rate of the UBX-NAV-TIMEGPS message on port UART1 (U1)
+	CfgKeyMsgoutUbxNavTimelsUart1  CfgKeyID = 0x20910061 // Output rate of the UBX-NAV-TIMELS message on port UART1 (U1)
+	CfgKeyMsgoutUbxNavTimeutcUart1 CfgKeyID = 0x2091005c // Output rate of the UBX-NAV-TIMEUTC message on port UART1 (U1)
+	CfgKeyMsgoutUbxNavVelecefUart1 CfgKeyID = 0x2091003e // Output rate of the UBX-NAV-VELECEF message on port UART1 (U1)
+	CfgKeyMsgoutUbxNavVelnedUart1  CfgKeyID = 0x20910043 // Output rate of the UBX-NAV-VELNED message on port UART1 (U1)
+	CfgKeyMsgoutUbxRxmMeasxUart1   CfgKeyID = 0x20910205 // Output rate of the UBX-RXM-MEASX message on port UART1 (U1)
+	CfgKeyMsgoutUbxRxmRawxUart1    CfgKeyID = 0x209102a5 // Output rate of the UBX-RXM-RAWX message on port UART1 (U1)
+	CfgKeyMsgoutUbxRxmSfrbxUart1   CfgKeyID = 0x20910232 // Output rate of the UBX-RXM-SFRBX message on port UART1 (U1)
+	CfgKeyMsgoutUbxSecEcsignUart1  CfgKeyID = 0x2091034b // Output rate of the UBX-SEC-ECSIGN message on port UART1 (U1)
+	CfgKeyMsgoutUbxSecSigUart1     CfgKeyID = 0x20910635 // Output rate of the UBX-SEC-SIG message on port UART1 (U1)
+	CfgKeyMsgoutUbxTimTpUart1      CfgKeyID = 0x2091017e // Output rate of the UBX-TIM-TP message on port UART1 (U1)
+	CfgKeyNavspgAckaiding          CfgKeyID = 0x10110025 // Acknowledge assistance input messages (L)
+	CfgKeyNavspgDynmodel           CfgKeyID = 0x20110021 // Dynamic platform model (E1)
+	CfgKeyNavspgFixmode            CfgKeyID = 0x20110011 // Position fix mode (E1)
+	CfgKeyNavspgInfilMaxsvs        CfgKeyID = 0x201100a2 // Maximum number of satellites for navigation (U1)
+	CfgKeyNavspgInfilMincno        CfgKeyID = 0x201100a3 // Minimum satellite signal level for navigation (U1)
+	CfgKeyNavspgInfilMinelev       CfgKeyID = 0x201100a4 // Minimum elevation for a GNSS satellite to be used in navigation (I1)
+	CfgKeyNavspgInfilMinsvs        CfgKeyID = 0x201100a1 // Minimum number of satellites for navigation (U1)
+	CfgKeyNavspgInifix3d           CfgKeyID = 0x10110013 // Initial fix must be a 3D fix (L)
+	CfgKeyNavspgOutfilFacc         CfgKeyID = 0x301100b5 // Output filter frequency accuracy mask (threshold) (U2)
+	CfgKeyNavspgOutfilPacc         CfgKeyID = 0x301100b3 // Output filter position accuracy mask (threshold) (U2)
+	CfgKeyNavspgOutfilPdop         CfgKeyID = 0x301100b1 // Output filter position DOP mask (threshold) (U2)
+	CfgKeyNavspgOutfilTacc         CfgKeyID = 0x301100b4 // Output filter time accuracy mask (threshold) (U2)
+	CfgKeyNavspgOutfilTdop         CfgKeyID = 0x301100b2 // Output filter time DOP mask (threshold) (U2)
+	CfgKeyNavspgUtcstandard        CfgKeyID = 0x2011001c // UTC standard to be used (E1)
+	CfgKeyPmOperatemode            CfgKeyID = 0x20d00001 // Power management setup (E1)
+	CfgKeyRateMeas                 CfgKeyID = 0x30210001 // Nominal time between GNSS measurements (U2)
+	CfgKeyRateNav                  CfgKeyID = 0x30210002 // Ratio of number of measurements to number of navigation solutions (U2)
+	CfgKeyRateTimeref              CfgKeyID = 0x20210003 // Time system to which measurements are aligned (E1)
+	CfgKeySbasUseRanging           CfgKeyID = 0x10360003 // Use SBAS GEOs as a ranging source (for navigation) (L)
+	CfgKeySbasUseTestmode          CfgKeyID = 0x10360002 // Use SBAS data when it is in test mode (L)
+	CfgKeySignalBdsB1Ena           CfgKeyID = 0x1031000d // BeiDou B1I (L)
+	CfgKeySignalBdsEna             CfgKeyID = 0x10310022 // BeiDou enable (L)
+	CfgKeySignalGalE1Ena           CfgKeyID = 0x10310007 // Galileo E1 (L)
+	CfgKeySignalGalEna             CfgKeyID = 0x10310021 // Galileo enable (L)
+	CfgKeySignalGloEna             CfgKeyID = 0x10310025 // GLONASS enable (L)
+	CfgKeySignalGloL1Ena           CfgKeyID = 0x10310018 // GLONASS L1 (L)
+	CfgKeySignalGpsEna             CfgKeyID = 0x1031001f // GPS enable (L)
+	CfgKeySignalGpsL1caEna         CfgKeyID = 0x10310001 // GPS L1C/A (L)
+	CfgKeySignalQzssEna            CfgKeyID = 0x10310024 // QZSS enable (L)
+	CfgKeySignalQzssL1caEna        CfgKeyID = 0x10310012 // QZSS L1C/A (L)
+	CfgKeySignalQzssL1sEna         CfgKeyID = 0x10310014 // QZSS L1S (L)
+	CfgKeySignalSbasEna            CfgKeyID = 0x10310020 // SBAS enable (L)
+	CfgKeySignalSbasL1caEna        CfgKeyID = 0x10310005 // SBAS L1C/A (L)
+	CfgKeySpiinprotUbx             CfgKeyID = 0x10790001 // Flag to indicate if UBX should be an input protocol on SPI (L)
+	CfgKeySpioutprotNmea           CfgKeyID = 0x107a0002 // Flag to indicate if NMEA should be an output protocol on SPI (L)
+	CfgKeySpioutprotUbx            CfgKeyID = 0x107a0001 // Flag to indicate if UBX should be an output protocol on SPI (L)
+	CfgKeyTpAlignToTowTp1          CfgKeyID = 0x1005000a // Align time pulse to top of second (TP1) (L)
+	CfgKeyTpAntCabledelay          CfgKeyID = 0x30050001 // Antenna cable delay (I2)
+	CfgKeyTpDutyLockTp1            CfgKeyID = 0x5005002b // Time pulse duty cycle when locked to GNSS time (TP1) (R8)
+	CfgKeyTpDutyTp1                CfgKeyID = 0x5005002a // Time pulse duty cycle (TP1) (R8)
+	CfgKeyTpFreqLockTp1            CfgKeyID = 0x40050025 // Time pulse frequency when locked to GNSS time (TP1) (U4)
+	CfgKeyTpFreqTp1                CfgKeyID = 0x40050024 // Time pulse frequency (TP1) (U4)
+	CfgKeyTpLenLockTp1             CfgKeyID = 0x40050005 // Time pulse length when locked to GNSS time (TP1) (U4)
+	CfgKeyTpLenTp1                 CfgKeyID = 0x40050004 // Time pulse length (TP1) (U4)
+	CfgKeyTpPeriodLockTp1          CfgKeyID = 0x40050003 // Time pulse period when locked to GNSS time (TP1) (U4)
+	CfgKeyTpPeriodTp1              CfgKeyID = 0x40050002 // Time pulse period (TP1) (U4)
+	CfgKeyTpPolTp1                 CfgKeyID = 0x1005000b // Set time pulse polarity (TP1) (L)
+	CfgKeyTpPulseDef               CfgKeyID = 0x20050023 // Determines whether the time pulse is interpreted as frequency or period (E1)
+	CfgKeyTpPulseLengthDef         CfgKeyID = 0x20050030 // Determines whether the time pulse length is interpreted as length or ratio (E1)
+	CfgKeyTpSyncGnssTp1            CfgKeyID = 0x10050008 // Sync time pulse to GNSS time or local clock (TP1) (L)
+	CfgKeyTpTimegridTp1            CfgKeyID = 0x2005000c // Time grid to use (TP1) (E1)
+	CfgKeyTpTp1Ena                 CfgKeyID = 0x10050007 // Enable the first timepulse (L)
+	CfgKeyTpUserDelayTp1           CfgKeyID = 0x40050006 // User configurable time pulse delay (TP1) (I4)
+	CfgKeyTpUseLockedTp1           CfgKeyID = 0x10050009 // Use locked parameters when possible (TP1) (L)
+	CfgKeyTxreadyEnabled           CfgKeyID = 0x10a20001 // Flag to indicate if TX ready pin mechanism should be enabled (L)
+	CfgKeyUart1Baudrate            CfgKeyID = 0x40520001 // The baud rate that should be configured on the UART1 (U4)
+	CfgKeyUart1Databits            CfgKeyID = 0x20520003 // Number of databits that should be used on UART1 (E1)
+	CfgKeyUart1Enabled             CfgKeyID = 0x10520005 // Flag to indicate if the UART1 should be enabled (L)
+	CfgKeyUart1Parity              CfgKeyID = 0x20520004 // Parity mode that should be used on UART1 (E1)
+	CfgKeyUart1Stopbits            CfgKeyID = 0x20520002 // Number of stopbits that should be used on UART1 (E1)
+	CfgKeyUart1inprotNmea          CfgKeyID = 0x10730002 // Flag to indicate if NMEA should be an input protocol on UART1 (L)
+	CfgKeyUart1inprotRtcm3x        CfgKeyID = 0x10730004 // Flag to indicate if RTCM3X should be an input protocol on UART1 (L)
+	CfgKeyUart1inprotUbx           CfgKeyID = 0x10730001 // Flag to indicate if UBX should be an input protocol on UART1 (L)
+	CfgKeyUart1outprotNmea         CfgKeyID = 0x10740002 // Flag to indicate if NMEA should be an output protocol on UART1 (L)
+	CfgKeyUart1outprotUbx          CfgKeyID = 0x10740001 // Flag to indicate if UBX should be an output protocol on UART1 (L)
+	CfgKeyUsbinprotUbx             CfgKeyID = 0x10770001 // Flag to indicate if UBX should be an input protocol on USB (L)
+	CfgKeyUsboutprotNmea           CfgKeyID = 0x10780002 // Flag to indicate if NMEA should be an output protocol on USB (L)
+	CfgKeyUsboutprotUbx            CfgKeyID = 0x10780001 // Flag to indicate if UBX should be an output protocol on USB (L)
+)
+
+var cfgKeys = []*CfgKey{
+	{
+		Name:        "CFG-I2C-ADDRESS",
+		ID:          CfgKeyI2cAddress,
+		Type:        "U1",
+		Description: "I2C slave address of the receiver (7 bits)",
+	},
+	{
+		Name:        "CFG-I2C-ENABLED",
+		ID:          CfgKeyI2cEnabled,
+		Type:        "L",
+		Description: "Flag to indicate if the I2C interface should be enabled",
+	},
+	{
+		Name:        "CFG-I2CINPROT-NMEA",
+		ID:          CfgKeyI2cinprotNmea,
+		Type:        "L",
+		Description: "Flag to indicate if NMEA should be an input protocol on I2C",
+	},
+	{
+		Name:        "CFG-I2CINPROT-UBX",
+		ID:          CfgKeyI2cinprotUbx,
+		Type:        "L",
+		Description: "Flag to indicate if UBX should be an input protocol on I2C",
+	},
+	{
+		Name:        "CFG-I2COUTPROT-NMEA",
+		ID:          CfgKeyI2coutprotNmea,
+		Type:        "L",
+		Description: "Flag to indicate if NMEA should be an output protocol on I2C",
+	},
+	{
+		Name:        "CFG-I2COUTPROT-UBX",
+		ID:          CfgKeyI2coutprotUbx,
+		Type:        "L",
+		Description: "Flag to indicate if UBX should be an output protocol on I2C",
+	},
+	{
+		Name:        "CFG-INFMSG-NMEA_UART1",
+		ID:          CfgKeyInfmsgNmeaUart1,
+		Type:        "X1",
+		Description: "Information message enable flags for the NMEA protocol on the UART1 interface",
+	},
+	{
+		Name:        "CFG-INFMSG-UBX_UART1",
+		ID:          CfgKeyInfmsgUbxUart1,
+		Type:        "X1",
+		Description: "Information message enable flags for the UBX protocol on the UART1 interface",
+	},
+	{
+		Name:        "CFG-ITFM-ENABLE",
+		ID:          CfgKeyItfmEnable,
+		Type:        "L",
+		Description: "Enable interference detection",
+	},
+	{
+		Name:        "CFG-MSGOUT-NMEA_ID_GGA_I2C",
+		ID:          CfgKeyMsgoutNmeaIdGgaI2c,
+		Type:        "U1",
+		Description: "Output rate of the NMEA-GX-GGA message on port I2C",
+	},
+	{
+		Name:        "CFG-MSGOUT-NMEA_ID_GGA_SPI",
+		ID:          CfgKeyMsgoutNmeaIdGgaSpi,
+		Type:        "U1",
+		Description: "Output rate of the NMEA-GX-GGA message on port SPI",
+	},
+	{
+		Name:        "CFG-MSGOUT-NMEA_ID_GGA_UART1",
+		ID:          CfgKeyMsgoutNmeaIdGgaUart1,
+		Type:        "U1",
+		Description: "Output rate of the NMEA-GX-GGA message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-NMEA_ID_GLL_I2C",
+		ID:          CfgKeyMsgoutNmeaIdGllI2c,
+		Type:        "U1",
+		Description: "Output rate of the NMEA-GX-GLL message on port I2C",
+	},
+	{
+		Name:        "CFG-MSGOUT-NMEA_ID_GLL_SPI",
+		ID:          CfgKeyMsgoutNmeaIdGllSpi,
+		Type:        "U1",
+		Description: "Output rate of the NMEA-GX-GLL message on port SPI",
+	},
+	{
+		Name:        "CFG-MSGOUT-NMEA_ID_GLL_UART1",
+		ID:          CfgKeyMsgoutNmeaIdGllUart1,
+		Type:        "U1",
+		Description: "Output rate of the NMEA-GX-GLL message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-NMEA_ID_GSA_I2C",
+		ID:          CfgKeyMsgoutNmeaIdGsaI2c,
+		Type:        "U1",
+		Description: "Output rate of the NMEA-GX-GSA message on port I2C",
+	},
+	{
+		Name:        "CFG-MSGOUT-NMEA_ID_GSA_SPI",
+		ID:          CfgKeyMsgoutNmeaIdGsaSpi,
+		Type:        "U1",
+		Description: "Output rate of the NMEA-GX-GSA message on port SPI",
+	},
+	{
+		Name:        "CFG-MSGOUT-NMEA_ID_GSA_UART1",
+		ID:          CfgKeyMsgoutNmeaIdGsaUart1,
+		Type:        "U1",
+		Description: "Output rate of the NMEA-GX-GSA message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-NMEA_ID_GSV_I2C",
+		ID:          CfgKeyMsgoutNmeaIdGsvI2c,
+		Type:        "U1",
+		Description: "Output rate of the NMEA-GX-GSV message on port I2C",
+	},
+	{
+		Name:        "CFG-MSGOUT-NMEA_ID_GSV_SPI",
+		ID:          CfgKeyMsgoutNmeaIdGsvSpi,
+		Type:        "U1",
+		Description: "Output rate of the NMEA-GX-GSV message on port SPI",
+	},
+	{
+		Name:        "CFG-MSGOUT-NMEA_ID_GSV_UART1",
+		ID:          CfgKeyMsgoutNmeaIdGsvUart1,
+		Type:        "U1",
+		Description: "Output rate of the NMEA-GX-GSV message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-NMEA_ID_RMC_I2C",
+		ID:          CfgKeyMsgoutNmeaIdRmcI2c,
+		Type:        "U1",
+		Description: "Output rate of the NMEA-GX-RMC message on port I2C",
+	},
+	{
+		Name:        "CFG-MSGOUT-NMEA_ID_RMC_SPI",
+		ID:          CfgKeyMsgoutNmeaIdRmcSpi,
+		Type:        "U1",
+		Description: "Output rate of the NMEA-GX-RMC message on port SPI",
+	},
+	{
+		Name:        "CFG-MSGOUT-NMEA_ID_RMC_UART1",
+		ID:          CfgKeyMsgoutNmeaIdRmcUart1,
+		Type:        "U1",
+		Description: "Output rate of the NMEA-GX-RMC message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-NMEA_ID_VTG_I2C",
+		ID:          CfgKeyMsgoutNmeaIdVtgI2c,
+		Type:        "U1",
+		Description: "Output rate of the NMEA-GX-VTG message on port I2C",
+	},
+	{
+		Name:        "CFG-MSGOUT-NMEA_ID_VTG_SPI",
+		ID:          CfgKeyMsgoutNmeaIdVtgSpi,
+		Type:        "U1",
+		Description: "Output rate of the NMEA-GX-VTG message on port SPI",
+	},
+	{
+		Name:        "CFG-MSGOUT-NMEA_ID_VTG_UART1",
+		ID:          CfgKeyMsgoutNmeaIdVtgUart1,
+		Type:        "U1",
+		Description: "Output rate of the NMEA-GX-VTG message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_MON_RF_UART1",
+		ID:          CfgKeyMsgoutUbxMonRfUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-MON-RF message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_MON_SPAN_UART1",
+		ID:          CfgKeyMsgoutUbxMonSpanUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-MON-SPAN message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_MON_SYS_UART1",
+		ID:          CfgKeyMsgoutUbxMonSysUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-MON-SYS message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_NAV_CLOCK_UART1",
+		ID:          CfgKeyMsgoutUbxNavClockUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-NAV-CLOCK message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_NAV_COV_UART1",
+		ID:          CfgKeyMsgoutUbxNavCovUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-NAV-COV message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_NAV_DOP_UART1",
+		ID:          CfgKeyMsgoutUbxNavDopUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-NAV-DOP message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_NAV_EOE_UART1",
+		ID:          CfgKeyMsgoutUbxNavEoeUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-NAV-EOE message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_NAV_POSECEF_UART1",
+		ID:          CfgKeyMsgoutUbxNavPosecefUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-NAV-POSECEF message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_NAV_POSLLH_UART1",
+		ID:          CfgKeyMsgoutUbxNavPosllhUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-NAV-POSLLH message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_NAV_PVT_UART1",
+		ID:          CfgKeyMsgoutUbxNavPvtUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-NAV-PVT message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_NAV_SAT_UART1",
+		ID:          CfgKeyMsgoutUbxNavSatUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-NAV-SAT message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_NAV_SIG_UART1",
+		ID:          CfgKeyMsgoutUbxNavSigUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-NAV-SIG message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_NAV_STATUS_UART1",
+		ID:          CfgKeyMsgoutUbxNavStatusUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-NAV-STATUS message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_NAV_TIMEGPS_UART1",
+		ID:          CfgKeyMsgoutUbxNavTimegpsUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-NAV-TIMEGPS message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_NAV_TIMELS_UART1",
+		ID:          CfgKeyMsgoutUbxNavTimelsUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-NAV-TIMELS message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_NAV_TIMEUTC_UART1",
+		ID:          CfgKeyMsgoutUbxNavTimeutcUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-NAV-TIMEUTC message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_NAV_VELECEF_UART1",
+		ID:          CfgKeyMsgoutUbxNavVelecefUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-NAV-VELECEF message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_NAV_VELNED_UART1",
+		ID:          CfgKeyMsgoutUbxNavVelnedUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-NAV-VELNED message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_RXM_MEASX_UART1",
+		ID:          CfgKeyMsgoutUbxRxmMeasxUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-RXM-MEASX message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_RXM_RAWX_UART1",
+		ID:          CfgKeyMsgoutUbxRxmRawxUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-RXM-RAWX message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_RXM_SFRBX_UART1",
+		ID:          CfgKeyMsgoutUbxRxmSfrbxUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-RXM-SFRBX message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_SEC_ECSIGN_UART1",
+		ID:          CfgKeyMsgoutUbxSecEcsignUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-SEC-ECSIGN message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_SEC_SIG_UART1",
+		ID:          CfgKeyMsgoutUbxSecSigUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-SEC-SIG message on port UART1",
+	},
+	{
+		Name:        "CFG-MSGOUT-UBX_TIM_TP_UART1",
+		ID:          CfgKeyMsgoutUbxTimTpUart1,
+		Type:        "U1",
+		Description: "Output rate of the UBX-TIM-TP message on port UART1",
+	},
+	{
+		Name:        "CFG-NAVSPG-ACKAIDING",
+		ID:          CfgKeyNavspgAckaiding,
+		Type:        "L",
+		Description: "Acknowledge assistance input messages",
+	},
+	{
+		Name:        "CFG-NAVSPG-DYNMODEL",
+		ID:          CfgKeyNavspgDynmodel,
+		Type:        "E1",
+		Description: "Dynamic platform model",
+		Enum: []CfgEnumValue{
+			{"PORT", 0},
+			{"STAT", 2},
+			{"PED", 3},
+			{"AUTOMOT", 4},
+			{"SEA", 5},
+			{"AIR1", 6},
+			{"AIR2", 7},
+			{"AIR4", 8},
+			{"WRIST", 9},
+		},
+	},
+	{
+		Name:        "CFG-NAVSPG-FIXMODE",
+		ID:          CfgKeyNavspgFixmode,
+		Type:        "E1",
+		Description: "Position fix mode",
+		Enum: []CfgEnumValue{
+			{"2DONLY", 1},
+			{"3DONLY", 2},
+			{"AUTO", 3},
+		},
+	},
+	{
+		Name:        "CFG-NAVSPG-INFIL_MAXSVS",
+		ID:          CfgKeyNavspgInfilMaxsvs,
+		Type:        "U1",
+		Description: "Maximum number of satellites for navigation",
+	},
+	{
+		Name:        "CFG-NAVSPG-INFIL_MINCNO",
+		ID:          CfgKeyNavspgInfilMincno,
+		Type:        "U1",
+		Unit:        "dBHz",
+		Description: "Minimum satellite signal level for navigation",
+	},
+	{
+		Name:        "CFG-NAVSPG-INFIL_MINELEV",
+		ID:          CfgKeyNavspgInfilMinelev,
+		Type:        "I1",
+		Unit:        "deg",
+		Description: "Minimum elevation for a GNSS satellite to be used in navigation",
+	},
+	{
+		Name:        "CFG-NAVSPG-INFIL_MINSVS",
+		ID:          CfgKeyNavspgInfilMinsvs,
+		Type:        "U1",
+		Description: "Minimum number of satellites for navigation",
+	},
+	{
+		Name:        "CFG-NAVSPG-INIFIX3D",
+		ID:          CfgKeyNavspgInifix3d,
+		Type:        "L",
+		Description: "Initial fix must be a 3D fix",
+	},
+	{
+		Name:        "CFG-NAVSPG-OUTFIL_FACC",
+		ID:          CfgKeyNavspgOutfilFacc,
+		Type:        "U2",
+		Scale:       0.01,
+		Unit:        "m/s",
+		Description: "Output filter frequency accuracy mask (threshold)",
+	},
+	{
+		Name:        "CFG-NAVSPG-OUTFIL_PACC",
+		ID:          CfgKeyNavspgOutfilPacc,
+		Type:        "U2",
+		Unit:        "m",
+		Description: "Output filter position accuracy mask (threshold)",
+	},
+	{
+		Name:        "CFG-NAVSPG-OUTFIL_PDOP",
+		ID:          CfgKeyNavspgOutfilPdop,
+		Type:        "U2",
+		Scale:       0.1,
+		Description: "Output filter position DOP mask (threshold)",
+	},
+	{
+		Name:        "CFG-NAVSPG-OUTFIL_TACC",
+		ID:          CfgKeyNavspgOutfilTacc,
+		Type:        "U2",
+		Unit:        "m",
+		Description: "Output filter time accuracy mask (threshold)",
+	},
+	{
+		Name:        "CFG-NAVSPG-OUTFIL_TDOP",
+		ID:          CfgKeyNavspgOutfilTdop,
+		Type:        "U2",
+		Scale:       0.1,
+		Description: "Output filter time DOP mask (threshold)",
+	},
+	{
+		Name:        "CFG-NAVSPG-UTCSTANDARD",
+		ID:          CfgKeyNavspgUtcstandard,
+		Type:        "E1",
+		Description: "UTC standard to be used",
+		Enum: []CfgEnumValue{
+			{"AUTO", 0},
+			{"USNO", 3},
+			{"EU", 5},
+			{"SU", 6},
+			{"NTSC", 7},
+		},
+	},
+	{
+		Name:        "CFG-PM-OPERATEMODE",
+		ID:          CfgKeyPmOperatemode,
+		Type:        "E1",
+		Description: "Power management setup",
+		Enum: []CfgEnumValue{
+			{"FULL", 0},
+			{"PSMOO", 1},
+			{"PSMCT", 2},
+		},
+	},
+	{
+		Name:        "CFG-RATE-MEAS",
+		ID:          CfgKeyRateMeas,
+		Type:        "U2",
+		Scale:       0.001,
+		Unit:        "s",
+		Description: "Nominal time between GNSS measurements",
+	},
+	{
+		Name:        "CFG-RATE-NAV",
+		ID:          CfgKeyRateNav,
+		Type:        "U2",
+		Description: "Ratio of number of measurements to number of navigation solutions",
+	},
+	{
+		Name:        "CFG-RATE-TIMEREF",
+		ID:          CfgKeyRateTimeref,
+		Type:        "E1",
+		Description: "Time system to which measurements are aligned",
+		Enum: []CfgEnumValue{
+			{"UTC", 0},
+			{"GPS", 1},
+			{"GLO", 2},
+			{"BDS", 3},
+			{"GAL", 4},
+		},
+	},
+	{
+		Name:        "CFG-SBAS-USE_RANGING",
+		ID:          CfgKeySbasUseRanging,
+		Type:        "L",
+		Description: "Use SBAS GEOs as a ranging source (for navigation)",
+	},
+	{
+		Name:        "CFG-SBAS-USE_TESTMODE",
+		ID:          CfgKeySbasUseTestmode,
+		Type:        "L",
+		Description: "Use SBAS data when it is in test mode",
+	},
+	{
+		Name:        "CFG-SIGNAL-BDS_B1_ENA",
+		ID:          CfgKeySignalBdsB1Ena,
+		Type:        "L",
+		Description: "BeiDou B1I",
+	},
+	{
+		Name:        "CFG-SIGNAL-BDS_ENA",
+		ID:          CfgKeySignalBdsEna,
+		Type:        "L",
+		Description: "BeiDou enable",
+	},
+	{
+		Name:        "CFG-SIGNAL-GAL_E1_ENA",
+		ID:          CfgKeySignalGalE1Ena,
+		Type:        "L",
+		Description: "Galileo E1",
+	},
+	{
+		Name:        "CFG-SIGNAL-GAL_ENA",
+		ID:          CfgKeySignalGalEna,
+		Type:        "L",
+		Description: "Galileo enable",
+	},
+	{
+		Name:        "CFG-SIGNAL-GLO_ENA",
+		ID:          CfgKeySignalGloEna,
+		Type:        "L",
+		Description: "GLONASS enable",
+	},
+	{
+		Name:        "CFG-SIGNAL-GLO_L1_ENA",
+		ID:          CfgKeySignalGloL1Ena,
+		Type:        "L",
+		Description: "GLONASS L1",
+	},
+	{
+		Name:        "CFG-SIGNAL-GPS_ENA",
+		ID:          CfgKeySignalGpsEna,
+		Type:        "L",
+		Description: "GPS enable",
+	},
+	{
+		Name:        "CFG-SIGNAL-GPS_L1CA_ENA",
+		ID:          CfgKeySignalGpsL1caEna,
+		Type:        "L",
+		Description: "GPS L1C/A",
+	},
+	{
+		Name:        "CFG-SIGNAL-QZSS_ENA",
+		ID:          CfgKeySignalQzssEna,
+		Type:        "L",
+		Description: "QZSS enable",
+	},
+	{
+		Name:        "CFG-SIGNAL-QZSS_L1CA_ENA",
+		ID:          CfgKeySignalQzssL1caEna,
+		Type:        "L",
+		Description: "QZSS L1C/A",
+	},
+	{
+		Name:        "CFG-SIGNAL-QZSS_L1S_ENA",
+		ID:          CfgKeySignalQzssL1sEna,
+		Type:        "L",
+		Description: "QZSS L1S",
+	},
+	{
+		Name:        "CFG-SIGNAL-SBAS_ENA",
+		ID:          CfgKeySignalSbasEna,
+		Type:        "L",
+		Description: "SBAS enable",
+	},
+	{
+		Name:        "CFG-SIGNAL-SBAS_L1CA_ENA",
+		ID:          CfgKeySignalSbasL1caEna,
+		Type:        "L",
+		Description: "SBAS L1C/A",
+	},
+	{
+		Name:        "CFG-SPIINPROT-UBX",
+		ID:          CfgKeySpiinprotUbx,
+		Type:        "L",
+		Description: "Flag to indicate if UBX should be an input protocol on SPI",
+	},
+	{
+		Name:        "CFG-SPIOUTPROT-NMEA",
+		ID:          CfgKeySpioutprotNmea,
+		Type:        "L",
+		Description: "Flag to indicate if NMEA should be an output protocol on SPI",
+	},
+	{
+		Name:        "CFG-SPIOUTPROT-UBX",
+		ID:          CfgKeySpioutprotUbx,
+		Type:        "L",
+		Description: "Flag to indicate if UBX should be an output protocol on SPI",
+	},
+	{
+		Name:        "CFG-TP-ALIGN_TO_TOW_TP1",
+		ID:          CfgKeyTpAlignToTowTp1,
+		Type:        "L",
+		Description: "Align time pulse to top of second (TP1)",
+	},
+	{
+		Name:        "CFG-TP-ANT_CABLEDELAY",
+		ID:          CfgKeyTpAntCabledelay,
+		Type:        "I2",
+		Scale:       1e-9,
+		Unit:        "s",
+		Description: "Antenna cable delay",
+	},
+	{
+		Name:        "CFG-TP-DUTY_LOCK_TP1",
+		ID:          CfgKeyTpDutyLockTp1,
+		Type:        "R8",
+		Unit:        "%",
+		Description: "Time pulse duty cycle when locked to GNSS time (TP1)",
+	},
+	{
+		Name:        "CFG-TP-DUTY_TP1",
+		ID:          CfgKeyTpDutyTp1,
+		Type:        "R8",
+		Unit:        "%",
+		Description: "Time pulse duty cycle (TP1)",
+	},
+	{
+		Name:        "CFG-TP-FREQ_LOCK_TP1",
+		ID:          CfgKeyTpFreqLockTp1,
+		Type:        "U4",
+		Unit:        "Hz",
+		Description: "Time pulse frequency when locked to GNSS time (TP1)",
+	},
+	{
+		Name:        "CFG-TP-FREQ_TP1",
+		ID:          CfgKeyTpFreqTp1,
+		Type:        "U4",
+		Unit:        "Hz",
+		Description: "Time pulse frequency (TP1)",
+	},
+	{
+		Name:        "CFG-TP-LEN_LOCK_TP1",
+		ID:          CfgKeyTpLenLockTp1,
+		Type:        "U4",
+		Scale:       1e-6,
+		Unit:        "s",
+		Description: "Time pulse length when locked to GNSS time (TP1)",
+	},
+	{
+		Name:        "CFG-TP-LEN_TP1",
+		ID:          CfgKeyTpLenTp1,
+		Type:        "U4",
+		Scale:       1e-6,
+		Unit:        "s",
+		Description: "Time pulse length (TP1)",
+	},
+	{
+		Name:        "CFG-TP-PERIOD_LOCK_TP1",
+		ID:          CfgKeyTpPeriodLockTp1,
+		Type:        "U4",
+		Scale:       1e-6,
+		Unit:        "s",
+		Description: "Time pulse period when locked to GNSS time (TP1)",
+	},
+	{
+		Name:        "CFG-TP-PERIOD_TP1",
+		ID:          CfgKeyTpPeriodTp1,
+		Type:        "U4",
+		Scale:       1e-6,
+		Unit:        "s",
+		Description: "Time pulse period (TP1)",
+	},
+	{
+		Name:        "CFG-TP-POL_TP1",
+		ID:          CfgKeyTpPolTp1,
+		Type:        "L",
+		Description: "Set time pulse polarity (TP1)",
+	},
+	{
+		Name:        "CFG-TP-PULSE_DEF",
+		ID:          CfgKeyTpPulseDef,
+		Type:        "E1",
+		Description: "Determines whether the time pulse is interpreted as frequency or period",
+		Enum: []CfgEnumValue{
+			{"PERIOD", 0},
+			{"FREQ", 1},
+		},
+	},
+	{
+		Name:        "CFG-TP-PULSE_LENGTH_DEF",
+		ID:          CfgKeyTpPulseLengthDef,
+		Type:        "E1",
+		Description: "Determines whether the time pulse length is interpreted as length or ratio",
+		Enum: []CfgEnumValue{
+			{"RATIO", 0},
+			{"LENGTH", 1},
+		},
+	},
+	{
+		Name:        "CFG-TP-SYNC_GNSS_TP1",
+		ID:          CfgKeyTpSyncGnssTp1,
+		Type:        "L",
+		Description: "Sync time pulse to GNSS time or local clock (TP1)",
+	},
+	{
+		Name:        "CFG-TP-TIMEGRID_TP1",
+		ID:          CfgKeyTpTimegridTp1,
+		Type:        "E1",
+		Description: "Time grid to use (TP1)",
+		Enum: []CfgEnumValue{
+			{"UTC", 0},
+			{"GPS", 1},
+			{"GLO", 2},
+			{"BDS", 3},
+			{"GAL", 4},
+		},
+	},
+	{
+		Name:        "CFG-TP-TP1_ENA",
+		ID:          CfgKeyTpTp1Ena,
+		Type:        "L",
+		Description: "Enable the first timepulse",
+	},
+	{
+		Name:        "CFG-TP-USER_DELAY_TP1",
+		ID:          CfgKeyTpUserDelayTp1,
+		Type:        "I4",
+		Scale:       1e-9,
+		Unit:        "s",
+		Description: "User configurable time pulse delay (TP1)",
+	},
+	{
+		Name:        "CFG-TP-USE_LOCKED_TP1",
+		ID:          CfgKeyTpUseLockedTp1,
+		Type:        "L",
+		Description: "Use locked parameters when possible (TP1)",
+	},
+	{
+		Name:        "CFG-TXREADY-ENABLED",
+		ID:          CfgKeyTxreadyEnabled,
+		Type:        "L",
+		Description: "Flag to indicate if TX ready pin mechanism should be enabled",
+	},
+	{
+		Name:        "CFG-UART1-BAUDRATE",
+		ID:          CfgKeyUart1Baudrate,
+		Type:        "U4",
+		Description: "The baud rate that should be configured on the UART1",
+	},
+	{
+		Name:        "CFG-UART1-DATABITS",
+		ID:          CfgKeyUart1Databits,
+		Type:        "E1",
+		Description: "Number of databits that should be used on UART1",
+		Enum: []CfgEnumValue{
+			{"EIGHT", 0},
+			{"SEVEN", 1},
+		},
+	},
+	{
+		Name:        "CFG-UART1-ENABLED",
+		ID:          CfgKeyUart1Enabled,
+		Type:        "L",
+		Description: "Flag to indicate if the UART1 should be enabled",
+	},
+	{
+		Name:        "CFG-UART1-PARITY",
+		ID:          CfgKeyUart1Parity,
+		Type:        "E1",
+		Description: "Parity mode that should be used on UART1",
+		Enum: []CfgEnumValue{
+			{"NONE", 0},
+			{"ODD", 1},
+			{"EVEN", 2},
+		},
+	},
+	{
+		Name:        "CFG-UART1-STOPBITS",
+		ID:          CfgKeyUart1Stopbits,
+		Type:        "E1",
+		Description: "Number of stopbits that should be used on UART1",
+		Enum: []CfgEnumValue{
+			{"HALF", 0},
+			{"ONE", 1},
+			{"ONEHALF", 2},
+			{"TWO", 3},
+		},
+	},
+	{
+		Name:        "CFG-UART1INPROT-NMEA",
+		ID:          CfgKeyUart1inprotNmea,
+		Type:        "L",
+		Description: "Flag to indicate if NMEA should be an input protocol on UART1",
+	},
+	{
+		Name:        "CFG-UART1INPROT-RTCM3X",
+		ID:          CfgKeyUart1inprotRtcm3x,
+		Type:        "L",
+		Description: "Flag to indicate if RTCM3X should be an input protocol on UART1",
+	},
+	{
+		Name:        "CFG-UART1INPROT-UBX",
+		ID:          CfgKeyUart1inprotUbx,
+		Type:        "L",
+		Description: "Flag to indicate if UBX should be an input protocol on UART1",
+	},
+	{
+		Name:        "CFG-UART1OUTPROT-NMEA",
+		ID:          CfgKeyUart1outprotNmea,
+		Type:        "L",
+		Description: "Flag to indicate if NMEA should be an output protocol on UART1",
+	},
+	{
+		Name:        "CFG-UART1OUTPROT-UBX",
+		ID:          CfgKeyUart1outprotUbx,
+		Type:        "L",
+		Description: "Flag to indicate if UBX should be an output protocol on UART1",
+	},
+	{
+		Name:        "CFG-USBINPROT-UBX",
+		ID:          CfgKeyUsbinprotUbx,
+		Type:        "L",
+		Description: "Flag to indicate if UBX should be an input protocol on USB",
+	},
+	{
+		Name:        "CFG-USBOUTPROT-NMEA",
+		ID:          CfgKeyUsboutprotNmea,
+		Type:        "L",
+		Description: "Flag to indicate if NMEA should be an output protocol on USB",
+	},
+	{
+		Name:        "CFG-USBOUTPROT-UBX",
+		ID:          CfgKeyUsboutprotUbx,
+		Type:        "L",
+		Description: "Flag to indicate if UBX should be an output protocol on USB",
+	},
+}
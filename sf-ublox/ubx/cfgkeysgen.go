@@ -0,0 +1,174 @@
+// This program generates cfgkeys.go from cfgkeys.xml
+
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+type Definitions struct {
+	Key []*Key
+}
+
+type Key struct {
+	Name        string
+	Id          string
+	Type        string
+	Scale       string
+	Unit        string
+	Description string
+	Constant    []*Constant
+}
+
+type Constant struct {
+	Name  string
+	Value string
+}
+
+// ConstName turns CFG-MSGOUT-UBX_NAV_PVT_UART1 into CfgKeyMsgoutUbxNavPvtUart1
+func (k *Key) ConstName() string {
+	parts := strings.FieldsFunc(strings.ToLower(strings.TrimPrefix(k.Name, "CFG-")), func(r rune) bool { return r == '-' || r == '_' })
+	for i, p := range parts {
+		parts[i] = strings.Title(p)
+	}
+	return "CfgKey" + strings.Join(parts, "")
+}
+
+func (k *Key) ID() uint32 {
+	v, err := strconv.ParseUint(k.Id, 0, 32)
+	if err != nil {
+		log.Fatalf("%s: invalid id %q: %v", k.Name, k.Id, err)
+	}
+	return uint32(v)
+}
+
+// ScaleValue is the scale as a Go literal, 0 for none
+func (k *Key) ScaleValue() string {
+	if k.Scale == "-" || k.Scale == "" {
+		return "0"
+	}
+	if _, err := strconv.ParseFloat(k.Scale, 64); err != nil {
+		log.Fatalf("%s: invalid scale %q: %v", k.Name, k.Scale, err)
+	}
+	return k.Scale
+}
+
+func (k *Key) UnitValue() string {
+	if k.Unit == "-" {
+		return ""
+	}
+	return k.Unit
+}
+
+// typeSizes are the size bits of the key IDs of each type
+var typeSizes = map[string]uint32{
+	"L": 1, "U1": 2, "I1": 2, "X1": 2, "E1": 2,
+	"U2": 3, "I2": 3, "X2": 3, "E2": 3,
+	"U4": 4, "I4": 4, "X4": 4, "E4": 4, "R4": 4,
+	"U8": 5, "I8": 5, "X8": 5, "R8": 5,
+}
+
+func (k *Key) check() {
+	size, ok := typeSizes[k.Type]
+	if !ok {
+		log.Fatalf("%s: invalid type %q", k.Name, k.Type)
+	}
+	if (k.ID()>>28)&0x7 != size {
+		log.Fatalf("%s: type %s doesn't match the size bits of 0x%08x", k.Name, k.Type, k.ID())
+	}
+	if len(k.Constant) > 0 && k.Type[0] != 'E' {
+		log.Fatalf("%s: only enumeration keys have constants", k.Name)
+	}
+	for _, c := range k.Constant {
+		if _, err := strconv.ParseInt(c.Value, 0, 64); err != nil {
+			log.Fatalf("%s: invalid value of constant %s: %v", k.Name, c.Name, err)
+		}
+	}
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("cfgkeysgen: ")
+	flag.Parse()
+
+	if len(flag.Args()) != 3 {
+		log.Fatalf("Usage: %s code.tmpl cfgkeys.xml code.go", os.Args[0])
+	}
+
+	tmpl, err := template.New(filepath.Base(flag.Arg(0))).Funcs(tmplfuncs).ParseFiles(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if flag.Arg(1) != "-" {
+		os.Stdin, err = os.Open(flag.Arg(1))
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	var definitions Definitions
+	if err := xml.NewDecoder(os.Stdin).Decode(&definitions); err != nil {
+		log.Fatal(err)
+	}
+
+	names := map[string]bool{}
+	ids := map[uint32]bool{}
+	for _, k := range definitions.Key {
+		k.check()
+		if names[k.Name] || ids[k.ID()] {
+			log.Fatalf("%s: duplicate key", k.Name)
+		}
+		names[k.Name] = true
+		ids[k.ID()] = true
+	}
+	sort.Slice(definitions.Key, func(i, j int) bool { return definitions.Key[i].Name < definitions.Key[j].Name })
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Generated Code -- DO NOT EDIT.\n//go:generate go run cfgkeysgen.go %s %s %s\n\n", flag.Arg(0), flag.Arg(1), flag.Arg(2))
+	if err := tmpl.Execute(&buf, definitions.Key); err != nil {
+		log.Fatal(err)
+	}
+
+	// try to format as valid Go
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Println(err)
+		b = buf.Bytes()
+	}
+
+	if flag.Arg(2) != "-" {
+		os.Stdout, err = os.Create(flag.Arg(2))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer os.Stdout.Close()
+	}
+
+	if _, err := os.Stdout.Write(b); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Helper functions for in the template
+var tmplfuncs = template.FuncMap{
+	"notabs": notabs,
+}
+
+var wstospace = strings.NewReplacer("\t", " ", "\n", " ")
+
+func notabs(s string) string { return wstospace.Replace(s) }
@@ -0,0 +1,163 @@
+package ubx
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// The CFG-VAL* messages of the generation 9 receivers aren't in messages.xml,
+// their key/value lists don't fit its repeated blocks. Keys are described in
+// cfgkeys.xml.
+
+// CfgData is a configuration value of CFG-VALSET or CFG-VALGET. Value is
+// anything Encode writes in the size of Key, e.g. the result of
+// CfgKey.Encode, and the raw bytes of the value in a decoded CFG-VALGET.
+type CfgData struct {
+	Key   CfgKeyID
+	Value interface{}
+}
+
+func (d *CfgData) String() string {
+	data, ok := d.Value.([]byte)
+	if !ok {
+		return fmt.Sprintf("%s = %v", d.Key, d.Value)
+	}
+	if key, ok := CfgKeyByID(d.Key); ok {
+		return fmt.Sprintf("%s = %s", key.Name, key.Format(data))
+	}
+	return fmt.Sprintf("%s = % x", d.Key, data)
+}
+
+// CfgValSet (Set) Set configuration item values
+// Class/Id 0x06 0x8a (4 + N*(4+size) bytes)
+type CfgValSet struct {
+	Version   byte
+	Layers    CfgValSetLayers
+	Reserved1 [2]byte
+	CfgData   []*CfgData
+}
+
+func (CfgValSet) classID() uint16 { return 0x8a06 }
+
+// CfgValSetLayers are the layers CFG-VALSET writes to and CFG-VALDEL deletes
+// from
+type CfgValSetLayers byte
+
+const (
+	CfgValSetLayersRam   CfgValSetLayers = 0x01
+	CfgValSetLayersBBR   CfgValSetLayers = 0x02
+	CfgValSetLayersFlash CfgValSetLayers = 0x04
+)
+
+// CfgValGetLayer is the single layer CFG-VALGET reads from
+type CfgValGetLayer byte
+
+const (
+	CfgValGetLayerRam     CfgValGetLayer = 0
+	CfgValGetLayerBBR     CfgValGetLayer = 1
+	CfgValGetLayerFlash   CfgValGetLayer = 2
+	CfgValGetLayerDefault CfgValGetLayer = 7
+)
+
+func (l CfgValGetLayer) String() string {
+	switch l {
+	case CfgValGetLayerRam:
+		return "ram"
+	case CfgValGetLayerBBR:
+		return "bbr"
+	case CfgValGetLayerFlash:
+		return "flash"
+	case CfgValGetLayerDefault:
+		return "default"
+	}
+	return fmt.Sprintf("layer %d", byte(l))
+}
+
+// CfgValGetReq (Poll Request) Get configuration items
+// Class/Id 0x06 0x8b (4 + N*4 bytes)
+// Keys may hold up to 64 keys, Position skips that many values of the reply
+// when it doesn't fit in a single message.
+type CfgValGetReq struct {
+	Version  byte
+	Layer    CfgValGetLayer
+	Position uint16
+	Keys     []CfgKeyID
+}
+
+func (CfgValGetReq) classID() uint16 { return 0x8b06 }
+
+// CfgValGet (Polled) Configuration items
+// Class/Id 0x06 0x8b (4 + N*(4+size) bytes)
+// The reply to CfgValGetReq, with Version 1. The Value of each CfgData is the
+// raw bytes of the value.
+type CfgValGet struct {
+	Version  byte
+	Layer    CfgValGetLayer
+	Position uint16
+	CfgData  []*CfgData
+}
+
+func (CfgValGet) classID() uint16 { return 0x8b06 }
+
+// Value returns the raw bytes of key in the reply
+func (m *CfgValGet) Value(key CfgKeyID) ([]byte, bool) {
+	for _, d := range m.CfgData {
+		if d.Key == key {
+			data, ok := d.Value.([]byte)
+			return data, ok
+		}
+	}
+	return nil, false
+}
+
+func (m *CfgValGet) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "CFG-VALGET %s layer, position %d", m.Layer, m.Position)
+	for _, d := range m.CfgData {
+		b.WriteString("\n  ")
+		b.WriteString(d.String())
+	}
+	return b.String()
+}
+
+// decodeCfgValGet decodes the payload of a CFG-VALGET reply, the key IDs
+// give the size of the values.
+func decodeCfgValGet(payload []byte) (*CfgValGet, error) {
+	if len(payload) < 4 {
+		return nil, errInvalidFrame
+	}
+	msg := &CfgValGet{
+		Version:  payload[0],
+		Layer:    CfgValGetLayer(payload[1]),
+		Position: binary.LittleEndian.Uint16(payload[2:]),
+	}
+	data := payload[4:]
+	for len(data) > 0 {
+		if len(data) < 4 {
+			return nil, fmt.Errorf("CFG-VALGET: truncated key")
+		}
+		key := CfgKeyID(binary.LittleEndian.Uint32(data))
+		size := key.Size()
+		if size == 0 {
+			return nil, fmt.Errorf("CFG-VALGET: invalid key %s", key)
+		}
+		if len(data) < 4+size {
+			return nil, fmt.Errorf("CFG-VALGET: truncated value of %s", key)
+		}
+		msg.CfgData = append(msg.CfgData, &CfgData{Key: key, Value: append([]byte(nil), data[4:4+size]...)})
+		data = data[4+size:]
+	}
+	return msg, nil
+}
+
+// CfgValDel (Set) Delete configuration items from the BBR and/or flash layers
+// Class/Id 0x06 0x8c (4 + N*4 bytes)
+type CfgValDel struct {
+	Version   byte
+	Layers    CfgValSetLayers
+	Reserved1 [2]byte
+	Keys      []CfgKeyID
+}
+
+func (CfgValDel) classID() uint16 { return 0x8c06 }
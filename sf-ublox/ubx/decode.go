@@ -59,6 +59,15 @@ func Decode(frame []byte) (msg Message, err error) {
 		return nil, errInvalidChkSum
 	}
 
+	// a CFG-VALGET reply is version 1, a poll request version 0
+	if payload := frame[6 : len(frame)-2]; header.ClassID == (CfgValGet{}).classID() && len(payload) > 0 && payload[0] == 0x01 {
+		cfg, err := decodeCfgValGet(payload)
+		if err != nil {
+			return nil, err
+		}
+		return cfg, nil
+	}
+
 	msg = mkMsg(header.ClassID, header.Length, frame[6:len(frame)-2])
 
 	if msg != nil {
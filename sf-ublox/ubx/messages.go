@@ -8,53 +8,6 @@ import (
 	"strings"
 )
 
-type CfgData struct {
-	Key   uint32
-	Value interface{}
-}
-type CfgValSet struct {
-	Version   byte
-	Layers    CfgValSetLayers
-	Reserved1 [2]byte
-	CfgData   []*CfgData
-}
-
-type CfgValSetLayers byte
-
-const (
-	CfgValSetLayersRam   AidHui1Flags = 0x0
-	CfgValSetLayersBBR   AidHui1Flags = 0x1
-	CfgValSetLayersFlash AidHui1Flags = 0x4
-)
-
-func (CfgValSet) classID() uint16 { return 0x8a06 }
-
-type CfgValGetReq struct {
-	Version byte
-	Layers  CfgValSetLayers
-	Key     uint32
-}
-
-func (CfgValGetReq) classID() uint16 { return 0x8b06 }
-
-type CfgValGet struct {
-	Version  byte
-	Layers   byte
-	Position uint16
-	CfgData  []*CfgData
-}
-
-func (CfgValGet) classID() uint16 { return 0x8b06 }
-
-type CfgValDel struct {
-	Version   byte
-	Layers    CfgValSetLayers
-	Reserved1 [2]byte
-	Key       uint32
-}
-
-func (CfgValDel) classID() uint16 { return 0x8c06 }
-
 // Message ubx-ack-ack
 
 // AckAck (Output) Message acknowledged
@@ -8516,7 +8469,7 @@ type SecEcsign struct {
 	EcdsaSignature [48]byte
 }
 
-func (SecEcsign) classID() uint16 { return 0x2704 }
+func (SecEcsign) classID() uint16 { return 0x0427 }
 
 
 // Message ubx-tim-dosc
@@ -0,0 +1,97 @@
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RunFunc runs a feed until ctx is cancelled or the feed fails. Returning nil
+// while ctx is still active is treated as a failure too, a feed is never
+// expected to end on its own.
+type RunFunc func(ctx context.Context) error
+
+// RestartHandler is called every time a feed is about to be restarted.
+type RestartHandler func(name string, restarts int, err error)
+
+// Supervisor restarts failed feeds with an exponential backoff until the
+// context is cancelled.
+type Supervisor struct {
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	onRestart      RestartHandler
+
+	wg sync.WaitGroup
+}
+
+func New(initialBackoff time.Duration, maxBackoff time.Duration, onRestart RestartHandler) *Supervisor {
+	return &Supervisor{
+		initialBackoff: initialBackoff,
+		maxBackoff:     maxBackoff,
+		onRestart:      onRestart,
+	}
+}
+
+// Go supervises run in its own goroutine.
+func (s *Supervisor) Go(ctx context.Context, name string, run RunFunc) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.supervise(ctx, name, run)
+	}()
+}
+
+// Wait blocks until every supervised feed has returned, which only happens
+// once their context is cancelled.
+func (s *Supervisor) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Supervisor) supervise(ctx context.Context, name string, run RunFunc) {
+	backoff := s.initialBackoff
+	restarts := 0
+	for {
+		startTime := time.Now()
+		err := safeRun(ctx, run)
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			err = fmt.Errorf("%s feed stopped", name)
+		}
+
+		// a feed which ran fine for a while starts over with a short backoff
+		if time.Since(startTime) > s.maxBackoff {
+			backoff = s.initialBackoff
+		}
+
+		restarts++
+		fmt.Printf("%s feed failed, restarting in %s (restart %d): %s\n", name, backoff, restarts, err)
+		if s.onRestart != nil {
+			s.onRestart(name, restarts, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > s.maxBackoff {
+			backoff = s.maxBackoff
+		}
+	}
+}
+
+// safeRun turns a panic of the feed into an error, the supervisor is there so
+// that one sensor doesn't bring the whole process down.
+func safeRun(ctx context.Context, run RunFunc) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return run(ctx)
+}
@@ -0,0 +1,74 @@
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SupervisorRestartsFailedFeed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var lock sync.Mutex
+	var names []string
+	var restarts []int
+	var errs []string
+	s := New(time.Millisecond, 4*time.Millisecond, func(name string, restart int, err error) {
+		lock.Lock()
+		defer lock.Unlock()
+		names = append(names, name)
+		restarts = append(restarts, restart)
+		errs = append(errs, err.Error())
+	})
+
+	runs := 0
+	healthy := make(chan struct{})
+	s.Go(ctx, "imu", func(ctx context.Context) error {
+		runs++
+		switch runs {
+		case 1:
+			return fmt.Errorf("spi read failed")
+		case 2:
+			panic("boom")
+		case 3:
+			return nil
+		}
+		close(healthy)
+		<-ctx.Done()
+		return nil
+	})
+
+	select {
+	case <-healthy:
+	case <-time.After(time.Second):
+		t.Fatal("feed was not restarted")
+	}
+
+	cancel()
+	s.Wait()
+
+	require.Equal(t, []string{"imu", "imu", "imu"}, names)
+	require.Equal(t, []int{1, 2, 3}, restarts)
+	require.Equal(t, []string{"spi read failed", "panic: boom", "imu feed stopped"}, errs)
+	require.Equal(t, 4, runs)
+}
+
+func Test_SupervisorStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	restarted := false
+	s := New(time.Hour, time.Hour, func(string, int, error) { restarted = true })
+	s.Go(ctx, "gnss", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	cancel()
+	s.Wait()
+	require.False(t, restarted)
+}
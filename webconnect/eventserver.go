@@ -0,0 +1,195 @@
+package webconnect
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Hivemapper/gnss-controller/device/neom9n"
+	"github.com/Hivemapper/hivemapper-data-logger/data"
+	"github.com/Hivemapper/hivemapper-data-logger/data/gnss"
+	"github.com/Hivemapper/hivemapper-data-logger/data/imu"
+	"github.com/Hivemapper/hivemapper-data-logger/data/magnetometer"
+	eventsv1 "github.com/Hivemapper/hivemapper-data-logger/gen/proto/sf/events/v1"
+	"github.com/Hivemapper/hivemapper-data-logger/gen/proto/sf/events/v1/eventsv1connect"
+	"github.com/Hivemapper/hivemapper-data-logger/logger"
+	"github.com/bufbuild/connect-go"
+	"github.com/daedaleanai/ublox/ubx"
+	"github.com/google/uuid"
+	"github.com/rs/cors"
+)
+
+// subscriptionBufferSize is the number of events queued for a single client
+// before new events get dropped for that client. The IMU alone emits ~200
+// events per second, so a slow client must never block the sensor feeds.
+const subscriptionBufferSize = 1000
+
+// EventServer is the network logger.Sink, streaming every record as an event
+// to the connected EventService clients.
+type EventServer struct {
+	lock          sync.Mutex
+	subscriptions data.Subscriptions
+	filters       map[string]*nameFilter
+	httpServer    *http.Server
+}
+
+func NewEventServer() *EventServer {
+	return &EventServer{
+		subscriptions: data.Subscriptions{},
+		filters:       map[string]*nameFilter{},
+	}
+}
+
+func (s *EventServer) Start(listenAddr string) error {
+	mux := http.NewServeMux()
+	path, handler := eventsv1connect.NewEventServiceHandler(s)
+	mux.Handle(path, handler)
+
+	s.httpServer = &http.Server{
+		Addr:    listenAddr,
+		Handler: cors.AllowAll().Handler(mux),
+	}
+
+	fmt.Println("Starting event server on", listenAddr)
+	err := s.httpServer.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("serving events: %w", err)
+	}
+	return nil
+}
+
+func (s *EventServer) Shutdown(ctx context.Context) error {
+	if s.httpServer == nil {
+		return nil
+	}
+	return s.httpServer.Shutdown(ctx)
+}
+
+func (s *EventServer) Events(ctx context.Context, req *connect.Request[eventsv1.EventsRequest], stream *connect.ServerStream[eventsv1.EventsResponse]) error {
+	subscriptionID := uuid.NewString()
+	sub := s.subscribe(subscriptionID, newNameFilter(req.Msg.Includes, req.Msg.Excludes))
+	defer s.unsubscribe(subscriptionID)
+
+	fmt.Printf("events client %s subscribed (includes: %v, excludes: %v)\n", subscriptionID, req.Msg.Includes, req.Msg.Excludes)
+
+	for {
+		select {
+		case <-ctx.Done():
+			fmt.Printf("events client %s disconnected\n", subscriptionID)
+			return nil
+		case event := <-sub.IncomingEvents:
+			payload, err := json.Marshal(event)
+			if err != nil {
+				return fmt.Errorf("marshalling event %s: %w", event.GetName(), err)
+			}
+			err = stream.Send(&eventsv1.EventsResponse{
+				Name:    event.GetName(),
+				Payload: payload,
+			})
+			if err != nil {
+				return fmt.Errorf("sending event %s: %w", event.GetName(), err)
+			}
+		}
+	}
+}
+
+// HandleEvent dispatches the event to every subscribed client whose filter
+// accepts it. It never blocks: events are dropped for clients that are not
+// keeping up.
+func (s *EventServer) HandleEvent(event data.Event) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for id, sub := range s.subscriptions {
+		if !s.filters[id].accept(event.GetName()) {
+			continue
+		}
+		select {
+		case sub.IncomingEvents <- event:
+		default:
+		}
+	}
+}
+
+func (s *EventServer) LogImu(record *logger.ImuRecord) error {
+	s.HandleEvent(imu.NewRawImuEvent(record.Acceleration, record.AngularRate, record.Temperature))
+	return nil
+}
+
+func (s *EventServer) LogMagnetometer(record *logger.MagnetometerRecord) error {
+	s.HandleEvent(magnetometer.NewMagnetometerEvent(record.SystemTime, record.X, record.Y, record.Z))
+	return nil
+}
+
+func (s *EventServer) HandleUbxMessage(msg interface{}) error {
+	if navPvt, ok := msg.(*ubx.NavPvt); ok {
+		s.HandleEvent(gnss.NewNavPvtEvent(time.Now().UTC(), navPvt))
+	}
+	return nil
+}
+
+// LogGnssAuth does nothing, the data handler publishes a GNSS_EVENT for every
+// gnss data, signed or not
+func (s *EventServer) LogGnssAuth(_ *neom9n.Data) error {
+	return nil
+}
+
+func (s *EventServer) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return s.Shutdown(ctx)
+}
+
+func (s *EventServer) subscribe(id string, filter *nameFilter) *data.Subscription {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	sub := &data.Subscription{
+		IncomingEvents: make(chan data.Event, subscriptionBufferSize),
+	}
+	s.subscriptions[id] = sub
+	s.filters[id] = filter
+	return sub
+}
+
+func (s *EventServer) unsubscribe(id string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.subscriptions, id)
+	delete(s.filters, id)
+}
+
+type nameFilter struct {
+	includes map[string]bool
+	excludes map[string]bool
+}
+
+func newNameFilter(includes []string, excludes []string) *nameFilter {
+	f := &nameFilter{
+		includes: map[string]bool{},
+		excludes: map[string]bool{},
+	}
+	for _, name := range includes {
+		f.includes[name] = true
+	}
+	for _, name := range excludes {
+		f.excludes[name] = true
+	}
+	return f
+}
+
+// accept returns true when the name is not excluded and either no includes
+// were requested or the name is explicitly included.
+func (f *nameFilter) accept(name string) bool {
+	if f.excludes[name] {
+		return false
+	}
+	if len(f.includes) == 0 {
+		return true
+	}
+	return f.includes[name]
+}
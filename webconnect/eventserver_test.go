@@ -0,0 +1,74 @@
+package webconnect
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Hivemapper/hivemapper-data-logger/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NameFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		includes []string
+		excludes []string
+		event    string
+		expected bool
+	}{
+		{
+			name:     "no filters",
+			event:    "IMU_RAW_EVENT",
+			expected: true,
+		},
+		{
+			name:     "included",
+			includes: []string{"GNSS_EVENT", "IMU_RAW_EVENT"},
+			event:    "IMU_RAW_EVENT",
+			expected: true,
+		},
+		{
+			name:     "not included",
+			includes: []string{"GNSS_EVENT"},
+			event:    "IMU_RAW_EVENT",
+			expected: false,
+		},
+		{
+			name:     "excluded",
+			excludes: []string{"IMU_RAW_EVENT"},
+			event:    "IMU_RAW_EVENT",
+			expected: false,
+		},
+		{
+			name:     "excluded wins over included",
+			includes: []string{"IMU_RAW_EVENT"},
+			excludes: []string{"IMU_RAW_EVENT"},
+			event:    "IMU_RAW_EVENT",
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f := newNameFilter(test.includes, test.excludes)
+			assert.Equal(t, test.expected, f.accept(test.event))
+		})
+	}
+}
+
+func Test_HandleEventDispatch(t *testing.T) {
+	s := NewEventServer()
+	gnssSub := s.subscribe("gnss", newNameFilter([]string{"GNSS_EVENT"}, nil))
+	allSub := s.subscribe("all", newNameFilter(nil, nil))
+
+	s.HandleEvent(data.NewBaseEvent("GNSS_EVENT", "GNSS", time.Now(), nil))
+	s.HandleEvent(data.NewBaseEvent("IMU_RAW_EVENT", "IMU", time.Now(), nil))
+
+	assert.Equal(t, 1, len(gnssSub.IncomingEvents))
+	assert.Equal(t, 2, len(allSub.IncomingEvents))
+
+	s.unsubscribe("all")
+	s.HandleEvent(data.NewBaseEvent("GNSS_EVENT", "GNSS", time.Now(), nil))
+	assert.Equal(t, 2, len(gnssSub.IncomingEvents))
+	assert.Equal(t, 2, len(allSub.IncomingEvents))
+}